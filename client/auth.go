@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+)
+
+const authorizationKey = "authorization"
+
+// WithToken returns a context whose calls are authenticated by the bearer token, instead
+// of the token given to NewLantern by WithBearerToken, if any.
+func WithToken(ctx context.Context, token string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(authorizationKey, "Bearer "+token)
+	return metadata.NewOutgoingContext(ctx, md)
+}
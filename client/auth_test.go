@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/grpc/metadata"
+	"strings"
+	"testing"
+)
+
+// metadataServer returns the authorization metadata of a request as the key of a vertex,
+// and its namespace as the value.
+type metadataServer struct {
+	pb.UnimplementedLanternServiceServer
+}
+
+func (metadataServer) GetVertex(ctx context.Context, _ *pb.GetVertexRequest) (*pb.GetVertexResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &pb.GetVertexResponse{Vertex: &pb.Vertex{
+		Key:   strings.Join(md.Get(authorizationKey), ","),
+		Value: &pb.Vertex_String_{String_: strings.Join(md.Get(namespaceKey), ",")},
+	}}, nil
+}
+
+func TestWithToken(t *testing.T) {
+	port := startServer(t, metadataServer{})
+
+	tests := []struct {
+		name  string
+		opts  []Option
+		token string
+		want  string
+	}{
+		{name: "no token"},
+		{name: "per call", token: "call", want: "Bearer call"},
+		{name: "per connection", opts: []Option{WithBearerToken("conn")}, want: "Bearer conn"},
+		{name: "per call overrides connection", opts: []Option{WithBearerToken("conn")}, token: "call", want: "Bearer call"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := connect(t, port, tt.opts...)
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = WithToken(ctx, tt.token)
+			}
+			got, err := l.GetVertex(ctx, "a")
+			if err != nil {
+				t.Fatalf("GetVertex() error = %v", err)
+			}
+			if got.Key != tt.want {
+				t.Errorf("authorization = %q, want %q", got.Key, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithNamespace(t *testing.T) {
+	port := startServer(t, metadataServer{})
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{name: "default"},
+		{name: "namespace", opts: []Option{WithNamespace("fraud")}, want: "fraud"},
+		{name: "namespace and token", opts: []Option{WithNamespace("fraud"), WithBearerToken("conn")}, want: "fraud"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := connect(t, port, tt.opts...).GetVertex(context.Background(), "a")
+			if err != nil {
+				t.Fatalf("GetVertex() error = %v", err)
+			}
+			if ns, _ := got.StringValue(); ns != tt.want {
+				t.Errorf("namespace = %q, want %q", ns, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"sync"
+	"time"
+)
+
+var ErrBulkLoaderClosed = errors.New("bulk loader is closed")
+
+// BulkLoader writes vertices and edges in batches over a single stream. It sends up to
+// window batches without waiting for their acknowledgements, and blocks when they are all
+// in flight. Once a batch fails, all following calls return the error.
+//
+// A BulkLoader is not goroutine safe.
+type BulkLoader struct {
+	stream    pb.LanternService_IngestClient
+	cancel    context.CancelFunc
+	batchSize int
+	batch     *pb.IngestRequest
+	id        uint64
+
+	// inflight has a slot for each batch waiting for its acknowledgement.
+	inflight chan struct{}
+	done     chan struct{}
+
+	mu     sync.Mutex
+	err    error
+	closed bool
+}
+
+// BulkLoader returns a BulkLoader which sends batches of batchSize vertices and edges,
+// and keeps up to window of them in flight. Close must be called to write the last batch.
+func (l *Lantern) BulkLoader(ctx context.Context, batchSize int, window int) (*BulkLoader, error) {
+	if batchSize <= 0 || window <= 0 {
+		return nil, fmt.Errorf("%w: batchSize and window must be greater than 0", ErrInvalidArgument)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := l.client.Ingest(ctx)
+	if err != nil {
+		cancel()
+		return nil, translate(err, nil)
+	}
+
+	b := &BulkLoader{
+		stream:    stream,
+		cancel:    cancel,
+		batchSize: batchSize,
+		batch:     &pb.IngestRequest{},
+		inflight:  make(chan struct{}, window),
+		done:      make(chan struct{}),
+	}
+	go b.receive()
+	return b, nil
+}
+
+func (b *BulkLoader) receive() {
+	defer close(b.done)
+	for {
+		response, err := b.stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			b.fail(translate(err, nil))
+			return
+		}
+		switch response.Status {
+		case pb.Status_STATUS_OK:
+		case pb.Status_STATUS_INVALID_REQUEST:
+			b.fail(fmt.Errorf("%w: batch %d: %s", ErrInvalidArgument, response.Id, response.Message))
+		case pb.Status_STATUS_RESOURCE_EXHAUSTED:
+			b.fail(fmt.Errorf("%w: batch %d: %s", ErrResourceExhausted, response.Id, response.Message))
+		default:
+			b.fail(fmt.Errorf("batch %d: %s", response.Id, response.Message))
+		}
+		<-b.inflight
+	}
+}
+
+func (b *BulkLoader) fail(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// Err returns the first error of the batches which have been acknowledged.
+func (b *BulkLoader) Err() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.err
+}
+
+func (b *BulkLoader) PutVertex(key string, value interface{}, ttl time.Duration) error {
+	v, err := nativeVertex{
+		key:        key,
+		value:      value,
+		expiration: time.Now().Add(ttl),
+	}.asVertex()
+	if err != nil {
+		return err
+	}
+
+	b.batch.Vertices = append(b.batch.Vertices, v)
+	return b.sendIfFull()
+}
+
+func (b *BulkLoader) AddEdge(tail string, head string, weight float32, ttl time.Duration) error {
+	b.batch.Edges = append(b.batch.Edges, &pb.Edge{
+		Tail:       tail,
+		Head:       head,
+		Weight:     weight,
+		Expiration: timestamppb.New(time.Now().Add(ttl)),
+	})
+	return b.sendIfFull()
+}
+
+func (b *BulkLoader) sendIfFull() error {
+	if len(b.batch.Vertices)+len(b.batch.Edges) < b.batchSize {
+		return b.Err()
+	}
+	return b.send()
+}
+
+// send sends the current batch, waiting for a slot of the window.
+func (b *BulkLoader) send() error {
+	if b.closed {
+		return ErrBulkLoaderClosed
+	}
+	if err := b.Err(); err != nil {
+		return err
+	}
+	if len(b.batch.Vertices)+len(b.batch.Edges) == 0 {
+		return nil
+	}
+
+	select {
+	case b.inflight <- struct{}{}:
+	case <-b.done:
+		if err := b.Err(); err != nil {
+			return err
+		}
+		return ErrBulkLoaderClosed
+	}
+
+	b.id++
+	b.batch.Id = b.id
+	if err := b.stream.Send(b.batch); err != nil {
+		// Send returns io.EOF when the stream is broken, and Recv returns the cause.
+		<-b.done
+		if cause := b.Err(); cause != nil {
+			return cause
+		}
+		return err
+	}
+	b.batch = &pb.IngestRequest{}
+	return nil
+}
+
+// Close sends the last batch, and waits for acknowledgements of all batches.
+func (b *BulkLoader) Close() error {
+	if b.closed {
+		return ErrBulkLoaderClosed
+	}
+	defer b.cancel()
+
+	err := b.send()
+	b.closed = true
+	if err != nil {
+		return err
+	}
+	if err := b.stream.CloseSend(); err != nil {
+		return err
+	}
+	<-b.done
+	return b.Err()
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// ingestServer accepts batches unless they contain a vertex with an empty key.
+type ingestServer struct {
+	pb.UnimplementedLanternServiceServer
+	mu       sync.Mutex
+	batches  int
+	vertices int
+	edges    int
+}
+
+func (s *ingestServer) Ingest(stream pb.LanternService_IngestServer) error {
+	for {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		response := &pb.IngestResponse{Id: request.Id, Status: pb.Status_STATUS_OK}
+		for _, v := range request.Vertices {
+			if v.Key == "" {
+				response.Status = pb.Status_STATUS_INVALID_REQUEST
+				response.Message = "key must not be empty"
+			}
+		}
+		if response.Status == pb.Status_STATUS_OK {
+			s.mu.Lock()
+			s.batches++
+			s.vertices += len(request.Vertices)
+			s.edges += len(request.Edges)
+			s.mu.Unlock()
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+func startIngestServer(t *testing.T) (*ingestServer, *Lantern) {
+	t.Helper()
+	s := &ingestServer{}
+	return s, connect(t, startServer(t, s))
+}
+
+func TestBulkLoader(t *testing.T) {
+	tests := []struct {
+		name        string
+		batchSize   int
+		window      int
+		vertices    []string
+		edges       int
+		wantBatches int
+		wantErr     error
+	}{
+		{name: "single batch", batchSize: 100, window: 1, vertices: []string{"a", "b"}, edges: 10, wantBatches: 1},
+		{name: "pipelined batches", batchSize: 3, window: 4, vertices: []string{"a", "b", "c"}, edges: 100, wantBatches: 35},
+		{name: "invalid vertex", batchSize: 2, window: 2, vertices: []string{"a", ""}, edges: 4, wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, l := startIngestServer(t)
+			b, err := l.BulkLoader(context.Background(), tt.batchSize, tt.window)
+			if err != nil {
+				t.Fatalf("BulkLoader() error = %v", err)
+			}
+
+			err = func() error {
+				for _, key := range tt.vertices {
+					if err := b.PutVertex(key, key, time.Minute); err != nil {
+						return err
+					}
+				}
+				for i := 0; i < tt.edges; i++ {
+					if err := b.AddEdge("a", "b", 1, time.Minute); err != nil {
+						return err
+					}
+				}
+				return b.Close()
+			}()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BulkLoader error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.batches != tt.wantBatches || s.vertices != len(tt.vertices) || s.edges != tt.edges {
+				t.Errorf("server receives %d batches of %d vertices and %d edges, want %d batches of %d vertices and %d edges",
+					s.batches, s.vertices, s.edges, tt.wantBatches, len(tt.vertices), tt.edges)
+			}
+		})
+	}
+}
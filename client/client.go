@@ -16,7 +16,15 @@ type Lantern struct {
 	client pb.LanternServiceClient
 }
 
-func NewLantern(hostname string, port int) (*Lantern, error) {
+func NewLantern(hostname string, port int, opts ...Option) (*Lantern, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	creds, err := o.credentials()
+	if err != nil {
+		return nil, err
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -25,7 +33,9 @@ func NewLantern(hostname string, port int) (*Lantern, error) {
 	chErr := make(chan error)
 
 	go func() {
-		conn, err := grpc.DialContext(ctx, hostname+":"+strconv.Itoa(port), grpc.WithInsecure())
+		// Trace context of ctx of each call is propagated to the server, if the global
+		// OpenTelemetry tracer provider and propagator are set by the application.
+		conn, err := grpc.DialContext(ctx, hostname+":"+strconv.Itoa(port), append(o.interceptors(), creds)...)
 		if err != nil {
 			chErr <- err
 			return
@@ -54,7 +64,7 @@ func (l *Lantern) Close() error {
 func (l *Lantern) GetVertex(ctx context.Context, key string) (*Vertex, error) {
 	result, err := l.client.GetVertex(ctx, &pb.GetVertexRequest{Key: key})
 	if err != nil {
-		return nil, err
+		return nil, translate(err, ErrVertexNotFound)
 	}
 	p := &Vertex{}
 	p.Key = result.Vertex.Key
@@ -62,6 +72,22 @@ func (l *Lantern) GetVertex(ctx context.Context, key string) (*Vertex, error) {
 	return p, nil
 }
 
+// GetVertices returns vertices found by keys, and keys which are not found.
+func (l *Lantern) GetVertices(ctx context.Context, keys []string) (map[string]*Vertex, []string, error) {
+	result, err := l.client.GetVertices(ctx, &pb.GetVerticesRequest{Keys: keys})
+	if err != nil {
+		return nil, nil, translate(err, ErrVertexNotFound)
+	}
+	vertices := make(map[string]*Vertex, len(result.Vertices))
+	for _, v := range result.Vertices {
+		p := &Vertex{}
+		p.Key = v.Key
+		p.Value = v.Value
+		vertices[v.Key] = p
+	}
+	return vertices, result.Missing, nil
+}
+
 func (l *Lantern) PutVertex(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
 	v, err := nativeVertex{
 		key:        key,
@@ -76,7 +102,31 @@ func (l *Lantern) PutVertex(ctx context.Context, key string, value interface{},
 		Vertices: []*pb.Vertex{v},
 	}
 	if _, err := l.client.PutVertex(ctx, request); err != nil {
-		return err
+		return translate(err, nil)
+	}
+	return nil
+}
+
+// PutVertices puts values of keys in a single request.
+func (l *Lantern) PutVertices(ctx context.Context, values map[string]interface{}, ttl time.Duration) error {
+	expiration := time.Now().Add(ttl)
+	request := &pb.PutVertexRequest{
+		Vertices: make([]*pb.Vertex, 0, len(values)),
+	}
+	for key, value := range values {
+		v, err := nativeVertex{
+			key:        key,
+			value:      value,
+			expiration: expiration,
+		}.asVertex()
+		if err != nil {
+			return err
+		}
+		request.Vertices = append(request.Vertices, v)
+	}
+
+	if _, err := l.client.PutVertex(ctx, request); err != nil {
+		return translate(err, nil)
 	}
 	return nil
 }
@@ -86,7 +136,7 @@ func (l *Lantern) DeleteVertex(ctx context.Context, key string) error {
 		Key: key,
 	}
 	if _, err := l.client.DeleteVertex(ctx, request); err != nil {
-		return err
+		return translate(err, nil)
 	}
 	return nil
 }
@@ -94,7 +144,7 @@ func (l *Lantern) DeleteVertex(ctx context.Context, key string) error {
 func (l *Lantern) GetEdge(ctx context.Context, tail string, head string) (float32, error) {
 	result, err := l.client.GetEdge(ctx, &pb.GetEdgeRequest{Tail: tail, Head: head})
 	if err != nil {
-		return 0, err
+		return 0, translate(err, ErrEdgeNotFound)
 	}
 	return result.Edge.Weight, nil
 }
@@ -111,7 +161,7 @@ func (l *Lantern) AddEdge(ctx context.Context, tail string, head string, weight
 		},
 	}
 	if _, err := l.client.AddEdge(ctx, request); err != nil {
-		return err
+		return translate(err, nil)
 	}
 	return nil
 }
@@ -128,7 +178,7 @@ func (l *Lantern) PutEdge(ctx context.Context, tail string, head string, weight
 		},
 	}
 	if _, err := l.client.PutEdge(ctx, request); err != nil {
-		return err
+		return translate(err, nil)
 	}
 	return nil
 }
@@ -139,21 +189,37 @@ func (l *Lantern) DeleteEdge(ctx context.Context, tail string, head string) erro
 		Head: head,
 	}
 	if _, err := l.client.DeleteEdge(ctx, request); err != nil {
-		return err
+		return translate(err, nil)
 	}
 	return nil
 }
 
-func (l *Lantern) Illuminate(ctx context.Context, seed string, step int, k int, tfidf bool) (*model.Graph[string, *Vertex], error) {
+// Direction is a direction in which Illuminate follows edges.
+type Direction int
+
+const (
+	Outbound = Direction(pb.Direction_DIRECTION_OUTBOUND)
+	Inbound  = Direction(pb.Direction_DIRECTION_INBOUND)
+	Both     = Direction(pb.Direction_DIRECTION_BOTH)
+)
+
+func (d Direction) String() string {
+	return pb.Direction(d).String()
+}
+
+// Illuminate returns the neighbor graph of seed, following edges in direction.
+// Edges of the graph keep their own direction, e.g. they point to seed if direction is Inbound.
+func (l *Lantern) Illuminate(ctx context.Context, seed string, step int, k int, tfidf bool, direction Direction) (*model.Graph[string, *Vertex], error) {
 	// In go-client, optimization is not implemented, but there is native implementation in papaya.
 	result, err := l.client.Illuminate(ctx, &pb.IlluminateRequest{
-		Seed:  seed,
-		Step:  uint32(step),
-		K:     uint32(k),
-		Tfidf: tfidf,
+		Seed:      seed,
+		Step:      uint32(step),
+		K:         uint32(k),
+		Tfidf:     tfidf,
+		Direction: pb.Direction(direction),
 	})
 	if err != nil {
-		return nil, err
+		return nil, translate(err, ErrVertexNotFound)
 	}
 	g := model.NewGraph[string, *Vertex]()
 	for _, v := range result.Graph.Vertices {
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+)
+
+type Edge struct {
+	Tail   string
+	Head   string
+	Weight float32
+}
+
+// ListOptions selects a page of edges. Edges are ordered by weight in descending order
+// unless Ascending is set. MinWeight and MaxWeight are inclusive bounds of weights, if not nil.
+type ListOptions struct {
+	PageSize  int
+	PageToken string
+	Ascending bool
+	MinWeight *float32
+	MaxWeight *float32
+}
+
+func (o ListOptions) request(key string) *pb.ListEdgesRequest {
+	request := &pb.ListEdgesRequest{
+		Key:       key,
+		PageSize:  uint32(o.PageSize),
+		PageToken: o.PageToken,
+		Order:     pb.EdgeOrder_EDGE_ORDER_WEIGHT_DESCENDING,
+		MinWeight: o.MinWeight,
+		MaxWeight: o.MaxWeight,
+	}
+	if o.Ascending {
+		request.Order = pb.EdgeOrder_EDGE_ORDER_WEIGHT_ASCENDING
+	}
+	return request
+}
+
+func edges(response *pb.ListEdgesResponse) []*Edge {
+	edges := make([]*Edge, 0, len(response.Edges))
+	for _, e := range response.Edges {
+		edges = append(edges, &Edge{
+			Tail:   e.Tail,
+			Head:   e.Head,
+			Weight: e.Weight,
+		})
+	}
+	return edges
+}
+
+// OutEdges returns a page of edges from tail, and a token of the next page which is empty
+// if there are no more edges.
+func (l *Lantern) OutEdges(ctx context.Context, tail string, options ListOptions) ([]*Edge, string, error) {
+	response, err := l.client.ListOutEdges(ctx, options.request(tail))
+	if err != nil {
+		return nil, "", translate(err, ErrVertexNotFound)
+	}
+	return edges(response), response.NextPageToken, nil
+}
+
+// InEdges returns a page of edges to head, and a token of the next page which is empty
+// if there are no more edges.
+func (l *Lantern) InEdges(ctx context.Context, head string, options ListOptions) ([]*Edge, string, error) {
+	response, err := l.client.ListInEdges(ctx, options.request(head))
+	if err != nil {
+		return nil, "", translate(err, ErrVertexNotFound)
+	}
+	return edges(response), response.NextPageToken, nil
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrVertexNotFound    = errors.New("vertex not found")
+	ErrEdgeNotFound      = errors.New("edge not found")
+	ErrNamespaceNotFound = errors.New("namespace not found")
+	ErrInvalidArgument   = errors.New("invalid argument")
+	ErrResourceExhausted = errors.New("resource exhausted")
+	ErrUnauthenticated   = errors.New("unauthenticated")
+	ErrPermissionDenied  = errors.New("permission denied")
+)
+
+// statusError keeps the gRPC status of an error returned by lantern-server,
+// and unwraps to one of the sentinel errors above.
+type statusError struct {
+	status   *status.Status
+	sentinel error
+}
+
+func (e *statusError) Error() string {
+	return e.sentinel.Error() + ": " + e.status.Message()
+}
+
+func (e *statusError) Unwrap() error {
+	return e.sentinel
+}
+
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// errorDomain and namespaceNotFoundReason are the domain and reason of the ErrorInfo detail
+// of codes.NotFound for a namespace which does not exist.
+const (
+	errorDomain             = "lantern"
+	namespaceNotFoundReason = "NAMESPACE_NOT_FOUND"
+)
+
+// namespaceNotFound reports whether s is about a namespace which does not exist.
+func namespaceNotFound(s *status.Status) bool {
+	for _, d := range s.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain && info.Reason == namespaceNotFoundReason {
+			return true
+		}
+	}
+	return false
+}
+
+// translate converts an error of lantern-server into one which can be tested with errors.Is.
+// notFound is the sentinel error for codes.NotFound, which depends on the RPC, or nil for RPCs
+// which do not look up vertices nor edges. Any RPC fails with ErrNamespaceNotFound if the
+// namespace of the request does not exist.
+func translate(err error, notFound error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var sentinel error
+	switch s.Code() {
+	case codes.NotFound:
+		sentinel = notFound
+		if namespaceNotFound(s) {
+			sentinel = ErrNamespaceNotFound
+		}
+	case codes.InvalidArgument:
+		sentinel = ErrInvalidArgument
+	case codes.ResourceExhausted:
+		sentinel = ErrResourceExhausted
+	case codes.Unauthenticated:
+		sentinel = ErrUnauthenticated
+	case codes.PermissionDenied:
+		sentinel = ErrPermissionDenied
+	}
+	if sentinel == nil {
+		return err
+	}
+	return &statusError{
+		status:   s,
+		sentinel: sentinel,
+	}
+}
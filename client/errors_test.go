@@ -0,0 +1,114 @@
+package client
+
+import (
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// namespaceNotFoundError returns an error of a missing namespace like lantern-server does.
+func namespaceNotFoundError(message string) error {
+	s, _ := status.New(codes.NotFound, message).WithDetails(&errdetails.ErrorInfo{Domain: errorDomain, Reason: namespaceNotFoundReason})
+	return s.Err()
+}
+
+func TestTranslate(t *testing.T) {
+	other := errors.New("connection refused")
+	tests := []struct {
+		name     string
+		err      error
+		notFound error
+		want     error
+		wantCode codes.Code
+	}{
+		{
+			name:     "vertex not found",
+			err:      status.Error(codes.NotFound, "vertex not found: a"),
+			notFound: ErrVertexNotFound,
+			want:     ErrVertexNotFound,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "edge not found",
+			err:      status.Error(codes.NotFound, "edge not found: a -> b"),
+			notFound: ErrEdgeNotFound,
+			want:     ErrEdgeNotFound,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "namespace not found",
+			err:      namespaceNotFoundError("namespace not found: fraud"),
+			notFound: ErrVertexNotFound,
+			want:     ErrNamespaceNotFound,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "namespace not found in a write",
+			err:      namespaceNotFoundError("namespace not found: fraud"),
+			want:     ErrNamespaceNotFound,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "vertex whose key looks like a namespace",
+			err:      status.Error(codes.NotFound, "namespace not found: fraud"),
+			notFound: ErrVertexNotFound,
+			want:     ErrVertexNotFound,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "invalid argument",
+			err:      status.Error(codes.InvalidArgument, "key must not be empty"),
+			notFound: ErrVertexNotFound,
+			want:     ErrInvalidArgument,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "resource exhausted",
+			err:      status.Error(codes.ResourceExhausted, "too many vertices"),
+			notFound: ErrVertexNotFound,
+			want:     ErrResourceExhausted,
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "unauthenticated",
+			err:      status.Error(codes.Unauthenticated, "missing bearer token"),
+			notFound: ErrVertexNotFound,
+			want:     ErrUnauthenticated,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "permission denied",
+			err:      status.Error(codes.PermissionDenied, `reader is not granted write on "a"`),
+			notFound: ErrVertexNotFound,
+			want:     ErrPermissionDenied,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "other code",
+			err:      status.Error(codes.Unavailable, "shutting down"),
+			notFound: ErrVertexNotFound,
+			want:     nil,
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "not a status",
+			err:      other,
+			notFound: ErrVertexNotFound,
+			want:     other,
+			wantCode: codes.Unknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translate(tt.err, tt.notFound)
+			if tt.want != nil && !errors.Is(got, tt.want) {
+				t.Errorf("translate() = %v, want %v", got, tt.want)
+			}
+			if code := status.Code(got); code != tt.wantCode {
+				t.Errorf("status.Code(translate()) = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/anaregdesign/lantern/client"
 	"log"
 	"time"
@@ -113,7 +114,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	if _, err := cli.GetVertex(ctx, "string"); err != nil {
+	if _, err := cli.GetVertex(ctx, "string"); errors.Is(err, client.ErrVertexNotFound) {
 		log.Printf("string vertex is deleted: %s\n", err)
 	}
 
@@ -164,9 +165,9 @@ func main() {
 	// 1 seconds later, second edge is expired
 	time.Sleep(1 * time.Second)
 
-	// weight of edge a->b is 0
-	if weight, err := cli.GetEdge(ctx, "a", "b"); err == nil {
-		log.Printf("weight at t=4: %f\n", weight)
+	// edge a->b is not found
+	if _, err := cli.GetEdge(ctx, "a", "b"); errors.Is(err, client.ErrEdgeNotFound) {
+		log.Printf("edge at t=4: %s\n", err)
 	}
 
 	/*
@@ -220,6 +221,7 @@ func main() {
 			k is a number of edges from each vertex.
 			tfidf is a flag to use tfidf or not. If tfidf is true, weight of edge is calculated by tfidf.
 			Else, weight of edge is calculated by weights of edges.
+			direction is a direction to follow edges: client.Outbound, client.Inbound or client.Both.
 
 			ex)
 			a -> b -> c -> d
@@ -250,7 +252,7 @@ func main() {
 	}
 
 	// illuminate from a with step 2 and k 2
-	if graph, err := cli.Illuminate(ctx, "a", 2, 2, false); err == nil {
+	if graph, err := cli.Illuminate(ctx, "a", 2, 2, false, client.Outbound); err == nil {
 		if jsonString, err := json.MarshalIndent(graph, "", "\t"); err == nil {
 			log.Printf("%s\n", jsonString)
 			/*
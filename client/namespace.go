@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"time"
+)
+
+// namespaceKey is a metadata key of the namespace of a call.
+const namespaceKey = "lantern-namespace"
+
+// EvictionPolicy chooses what a namespace does when it exceeds its limits.
+type EvictionPolicy int
+
+const (
+	// EvictionReject rejects mutations exceeding the limits with ErrResourceExhausted.
+	EvictionReject = EvictionPolicy(pb.EvictionPolicy_EVICTION_POLICY_REJECT)
+	// EvictionLRU evicts vertices and edges which are least recently used.
+	EvictionLRU = EvictionPolicy(pb.EvictionPolicy_EVICTION_POLICY_LRU)
+	// EvictionLFU evicts vertices and edges which are least frequently used.
+	EvictionLFU = EvictionPolicy(pb.EvictionPolicy_EVICTION_POLICY_LFU)
+	// EvictionSoonestExpiring evicts vertices and edges which expire soonest.
+	EvictionSoonestExpiring = EvictionPolicy(pb.EvictionPolicy_EVICTION_POLICY_SOONEST_EXPIRING)
+	// EvictionLowestWeight evicts edges with the lowest weights, and vertices with the lowest
+	// sums of weights of their edges.
+	EvictionLowestWeight = EvictionPolicy(pb.EvictionPolicy_EVICTION_POLICY_LOWEST_WEIGHT)
+)
+
+func (p EvictionPolicy) String() string {
+	return pb.EvictionPolicy(p).String()
+}
+
+// DegreeRank orders edges from a vertex to keep when it exceeds its maximum out-degree.
+type DegreeRank int
+
+const (
+	// RankWeight keeps edges with the highest weights.
+	RankWeight = DegreeRank(pb.DegreeRank_DEGREE_RANK_WEIGHT)
+	// RankRecency keeps edges whose weights are added most recently.
+	RankRecency = DegreeRank(pb.DegreeRank_DEGREE_RANK_RECENCY)
+)
+
+func (r DegreeRank) String() string {
+	return pb.DegreeRank(r).String()
+}
+
+// Namespace is an independent graph hosted by lantern-server. Zero DefaultTTL and SweepInterval
+// are replaced with the defaults of the server on creation. Zero MaxVertices, MaxEdges and
+// MaxBytes mean unlimited, and a zero EvictionPolicy rejects mutations beyond the limits.
+// MaxBytes is an approximate memory footprint of vertices and edges. MaxOutDegree evicts the
+// weakest edges from a vertex beyond it by OutDegreeRank, the lightest ones if it is zero.
+// DecayHalfLife makes weights of edges halve every half-life since they are added, and zero
+// keeps them as they are. SlidingTTL extends expirations of vertices and edges to the TTL after
+// each read, and zero keeps them as they are.
+type Namespace struct {
+	Name           string
+	DefaultTTL     time.Duration
+	MaxVertices    int
+	MaxEdges       int
+	MaxBytes       int64
+	EvictionPolicy EvictionPolicy
+	MaxOutDegree   int
+	OutDegreeRank  DegreeRank
+	DecayHalfLife  time.Duration
+	SlidingTTL     time.Duration
+	SweepInterval  time.Duration
+	// Vertices and Edges are the current numbers of vertices and edges, ignored on creation.
+	Vertices int
+	Edges    int
+}
+
+func namespaceOf(n *pb.Namespace) *Namespace {
+	return &Namespace{
+		Name:           n.Name,
+		DefaultTTL:     time.Duration(n.DefaultTtlSeconds) * time.Second,
+		MaxVertices:    int(n.MaxVertices),
+		MaxEdges:       int(n.MaxEdges),
+		MaxBytes:       int64(n.MaxBytes),
+		EvictionPolicy: EvictionPolicy(n.EvictionPolicy),
+		MaxOutDegree:   int(n.MaxOutDegree),
+		OutDegreeRank:  DegreeRank(n.OutDegreeRank),
+		DecayHalfLife:  time.Duration(n.DecayHalfLifeSeconds) * time.Second,
+		SlidingTTL:     time.Duration(n.SlidingTtlSeconds) * time.Second,
+		SweepInterval:  time.Duration(n.SweepIntervalSeconds) * time.Second,
+		Vertices:       int(n.Vertices),
+		Edges:          int(n.Edges),
+	}
+}
+
+// CreateNamespace creates a namespace, which is addressed by WithNamespace.
+func (l *Lantern) CreateNamespace(ctx context.Context, n Namespace) (*Namespace, error) {
+	response, err := l.client.CreateNamespace(ctx, &pb.CreateNamespaceRequest{
+		Namespace: &pb.Namespace{
+			Name:                 n.Name,
+			DefaultTtlSeconds:    uint32(n.DefaultTTL / time.Second),
+			MaxVertices:          uint64(n.MaxVertices),
+			MaxEdges:             uint64(n.MaxEdges),
+			MaxBytes:             uint64(n.MaxBytes),
+			EvictionPolicy:       pb.EvictionPolicy(n.EvictionPolicy),
+			MaxOutDegree:         uint32(n.MaxOutDegree),
+			OutDegreeRank:        pb.DegreeRank(n.OutDegreeRank),
+			DecayHalfLifeSeconds: uint32(n.DecayHalfLife / time.Second),
+			SlidingTtlSeconds:    uint32(n.SlidingTTL / time.Second),
+			SweepIntervalSeconds: uint32(n.SweepInterval / time.Second),
+		},
+	})
+	if err != nil {
+		return nil, translate(err, ErrNamespaceNotFound)
+	}
+	return namespaceOf(response.Namespace), nil
+}
+
+// ListNamespaces returns all namespaces including the default one, in the order of their names.
+func (l *Lantern) ListNamespaces(ctx context.Context) ([]*Namespace, error) {
+	response, err := l.client.ListNamespaces(ctx, &pb.ListNamespacesRequest{})
+	if err != nil {
+		return nil, translate(err, ErrNamespaceNotFound)
+	}
+	namespaces := make([]*Namespace, 0, len(response.Namespaces))
+	for _, n := range response.Namespaces {
+		namespaces = append(namespaces, namespaceOf(n))
+	}
+	return namespaces, nil
+}
+
+// FlushNamespace removes all vertices and edges of a namespace.
+func (l *Lantern) FlushNamespace(ctx context.Context, name string) error {
+	if _, err := l.client.FlushNamespace(ctx, &pb.FlushNamespaceRequest{Name: name}); err != nil {
+		return translate(err, ErrNamespaceNotFound)
+	}
+	return nil
+}
+
+// DropNamespace removes a namespace with all of its vertices and edges.
+func (l *Lantern) DropNamespace(ctx context.Context, name string) error {
+	if _, err := l.client.DropNamespace(ctx, &pb.DropNamespaceRequest{Name: name}); err != nil {
+		return translate(err, ErrNamespaceNotFound)
+	}
+	return nil
+}
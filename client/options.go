@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"os"
+)
+
+// Option configures a connection of NewLantern. The connection is insecure unless any TLS
+// option is given.
+type Option func(*options)
+
+type options struct {
+	tls        bool
+	caFile     string
+	certFile   string
+	keyFile    string
+	serverName string
+	token      string
+	namespace  string
+}
+
+// WithTLS connects over TLS, verifying the server with the system pool of CAs.
+func WithTLS() Option {
+	return func(o *options) {
+		o.tls = true
+	}
+}
+
+// WithCABundle connects over TLS, verifying the server with the PEM encoded CAs in file.
+func WithCABundle(file string) Option {
+	return func(o *options) {
+		o.tls = true
+		o.caFile = file
+	}
+}
+
+// WithClientCertificate connects over TLS, presenting the certificate for servers which
+// verify clients (mutual TLS).
+func WithClientCertificate(certFile, keyFile string) Option {
+	return func(o *options) {
+		o.tls = true
+		o.certFile = certFile
+		o.keyFile = keyFile
+	}
+}
+
+// WithServerName connects over TLS, verifying the server as name instead of its hostname.
+func WithServerName(name string) Option {
+	return func(o *options) {
+		o.tls = true
+		o.serverName = name
+	}
+}
+
+// WithBearerToken sends token with every call of the connection, unless a call carries
+// its own token by WithToken. The token is sent even without TLS.
+func WithBearerToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+	}
+}
+
+// WithNamespace sends every call of the connection to the namespace of name, instead of the
+// default namespace.
+func WithNamespace(name string) Option {
+	return func(o *options) {
+		o.namespace = name
+	}
+}
+
+// outgoing attaches metadata of the options to ctx of a call, keeping the token of WithToken.
+func (o *options) outgoing(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	if o.token != "" && len(md.Get(authorizationKey)) == 0 {
+		md.Set(authorizationKey, "Bearer "+o.token)
+	}
+	if o.namespace != "" {
+		md.Set(namespaceKey, o.namespace)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func (o *options) interceptors() []grpc.DialOption {
+	unary := []grpc.UnaryClientInterceptor{otelgrpc.UnaryClientInterceptor()}
+	stream := []grpc.StreamClientInterceptor{otelgrpc.StreamClientInterceptor()}
+	if o.token != "" || o.namespace != "" {
+		unary = append(unary, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(o.outgoing(ctx), method, req, reply, cc, opts...)
+		})
+		stream = append(stream, func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(o.outgoing(ctx), desc, cc, method, opts...)
+		})
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(stream...),
+	}
+}
+
+func (o *options) credentials() (grpc.DialOption, error) {
+	if !o.tls {
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: o.serverName,
+	}
+	if o.caFile != "" {
+		b, err := os.ReadFile(o.caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificate found in %s", o.caFile)
+		}
+	}
+	if o.certFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.certFile, o.keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOptions_credentials(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalid, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantTLS bool
+		wantErr bool
+	}{
+		{name: "insecure"},
+		{name: "system CAs", opts: []Option{WithTLS()}, wantTLS: true},
+		{name: "server name", opts: []Option{WithServerName("lantern")}, wantTLS: true},
+		{name: "missing CA bundle", opts: []Option{WithCABundle(filepath.Join(dir, "missing.pem"))}, wantTLS: true, wantErr: true},
+		{name: "invalid CA bundle", opts: []Option{WithCABundle(invalid)}, wantTLS: true, wantErr: true},
+		{name: "invalid client certificate", opts: []Option{WithClientCertificate(invalid, invalid)}, wantTLS: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o options
+			for _, opt := range tt.opts {
+				opt(&o)
+			}
+			if o.tls != tt.wantTLS {
+				t.Errorf("tls = %v, want %v", o.tls, tt.wantTLS)
+			}
+			if _, err := o.credentials(); (err != nil) != tt.wantErr {
+				t.Errorf("credentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
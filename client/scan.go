@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+)
+
+// ScanOptions selects vertices to scan. Keys start with Prefix and match Pattern, a glob of
+// path.Match, if they are not empty. Values and Expirations are set to the vertices only if
+// requested. PageToken resumes a scan from Token of an iterator.
+type ScanOptions struct {
+	Prefix      string
+	Pattern     string
+	PageSize    int
+	PageToken   string
+	Values      bool
+	Expirations bool
+}
+
+// VertexIterator iterates vertices in the order of their keys, fetching a page at a time.
+//
+//	it := cli.ScanVertices(ctx, client.ScanOptions{Prefix: "user:"})
+//	for it.Next() {
+//		log.Println(it.Vertex().Key)
+//	}
+//	if err := it.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type VertexIterator struct {
+	ctx     context.Context
+	client  pb.LanternServiceClient
+	request *pb.ScanVerticesRequest
+	page    []*pb.Vertex
+	vertex  *Vertex
+	token   string
+	done    bool
+	err     error
+}
+
+// ScanVertices returns an iterator of vertices selected by options.
+// Vertices added or deleted during the scan may or may not be returned, but the others are
+// returned exactly once.
+func (l *Lantern) ScanVertices(ctx context.Context, options ScanOptions) *VertexIterator {
+	return &VertexIterator{
+		ctx:    ctx,
+		client: l.client,
+		request: &pb.ScanVerticesRequest{
+			Prefix:             options.Prefix,
+			Pattern:            options.Pattern,
+			PageSize:           uint32(options.PageSize),
+			PageToken:          options.PageToken,
+			IncludeValues:      options.Values,
+			IncludeExpirations: options.Expirations,
+		},
+		token: options.PageToken,
+	}
+}
+
+// Next advances the iterator to the next vertex, and reports whether there is one.
+func (it *VertexIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			it.vertex = nil
+			return false
+		}
+		it.request.PageToken = it.token
+		response, err := it.client.ScanVertices(it.ctx, it.request)
+		if err != nil {
+			it.err = translate(err, nil)
+			continue
+		}
+		it.page = response.Vertices
+		it.token = response.NextPageToken
+		it.done = response.NextPageToken == ""
+	}
+	it.vertex = (*Vertex)(it.page[0])
+	it.page = it.page[1:]
+	return true
+}
+
+// Vertex returns the current vertex.
+func (it *VertexIterator) Vertex() *Vertex {
+	return it.vertex
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (it *VertexIterator) Err() error {
+	return it.err
+}
+
+// Token returns a page token to resume the scan after the current page, which is empty
+// if there are no more pages.
+func (it *VertexIterator) Token() string {
+	return it.token
+}
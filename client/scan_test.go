@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// scanServer serves keys page by page, using the index of the next key as a page token.
+type scanServer struct {
+	pb.UnimplementedLanternServiceServer
+	keys []string
+}
+
+func (s *scanServer) ScanVertices(ctx context.Context, request *pb.ScanVerticesRequest) (*pb.ScanVerticesResponse, error) {
+	if request.Pattern == "[" {
+		return nil, status.Error(codes.InvalidArgument, "invalid pattern")
+	}
+	start := 0
+	if request.PageToken != "" {
+		start, _ = strconv.Atoi(request.PageToken)
+	}
+	end := start + int(request.PageSize)
+	response := &pb.ScanVerticesResponse{}
+	if end < len(s.keys) {
+		response.NextPageToken = strconv.Itoa(end)
+	} else {
+		end = len(s.keys)
+	}
+	for _, key := range s.keys[start:end] {
+		response.Vertices = append(response.Vertices, &pb.Vertex{Key: key})
+	}
+	return response, nil
+}
+
+func startScanServer(t *testing.T, keys []string) *Lantern {
+	t.Helper()
+	return connect(t, startServer(t, &scanServer{keys: keys}))
+}
+
+func TestVertexIterator(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		options ScanOptions
+		want    []string
+		wantErr error
+	}{
+		{name: "several pages", keys: []string{"a", "b", "c", "d", "e"}, options: ScanOptions{PageSize: 2}, want: []string{"a", "b", "c", "d", "e"}},
+		{name: "single page", keys: []string{"a", "b"}, options: ScanOptions{PageSize: 10}, want: []string{"a", "b"}},
+		{name: "resumed", keys: []string{"a", "b", "c", "d"}, options: ScanOptions{PageSize: 2, PageToken: "2"}, want: []string{"c", "d"}},
+		{name: "no vertices", options: ScanOptions{PageSize: 2}},
+		{name: "error", keys: []string{"a"}, options: ScanOptions{Pattern: "["}, wantErr: ErrInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := startScanServer(t, tt.keys).ScanVertices(context.Background(), tt.options)
+			var got []string
+			for it.Next() {
+				got = append(got, it.Vertex().Key)
+			}
+			if !errors.Is(it.Err(), tt.wantErr) {
+				t.Fatalf("Err() = %v, want %v", it.Err(), tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("keys = %v, want %v", got, tt.want)
+			}
+			if it.Token() != "" && tt.wantErr == nil {
+				t.Errorf("Token() = %q after the last page", it.Token())
+			}
+		})
+	}
+}
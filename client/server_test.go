@@ -0,0 +1,33 @@
+package client
+
+import (
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/grpc"
+	"net"
+	"testing"
+)
+
+// startServer serves s over a loopback connection until the test ends, and returns its port.
+func startServer(t *testing.T, s pb.LanternServiceServer) int {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterLanternServiceServer(server, s)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// connect returns a client of the server on port, which is closed when the test ends.
+func connect(t *testing.T, port int, opts ...Option) *Lantern {
+	t.Helper()
+	l, err := NewLantern("127.0.0.1", port, opts...)
+	if err != nil {
+		t.Fatalf("NewLantern() error = %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return l
+}
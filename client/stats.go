@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+)
+
+// Bucket counts values greater than UpperBound of the previous bucket, and less than or
+// equal to its own UpperBound.
+type Bucket struct {
+	UpperBound float64
+	Count      int
+}
+
+// Degree is the number of edges from and to a vertex.
+type Degree struct {
+	Key string
+	Out int
+	In  int
+}
+
+// Stats summarizes vertices and edges stored in lantern-server.
+type Stats struct {
+	Vertices    int
+	NilVertices int
+	Edges       int
+	// DegreeHistogram counts vertices by the sum of their out and in degrees.
+	DegreeHistogram []Bucket
+	// TopDegrees lists vertices in descending order of the sum of their out and in degrees.
+	TopDegrees []Degree
+	// TTLHistogram counts vertices and weights of edges by seconds until they expire.
+	TTLHistogram []Bucket
+	// MemoryBytes is an approximate memory footprint of the graph.
+	MemoryBytes int64
+}
+
+func buckets(h []*pb.HistogramBucket) []Bucket {
+	buckets := make([]Bucket, 0, len(h))
+	for _, b := range h {
+		buckets = append(buckets, Bucket{UpperBound: b.UpperBound, Count: int(b.Count)})
+	}
+	return buckets
+}
+
+// Stats returns statistics of the graph with top vertices of the highest degrees.
+// The server chooses the number of top vertices if top is 0.
+func (l *Lantern) Stats(ctx context.Context, top int) (*Stats, error) {
+	response, err := l.client.Stats(ctx, &pb.StatsRequest{Top: uint32(top)})
+	if err != nil {
+		return nil, translate(err, nil)
+	}
+	stats := &Stats{
+		Vertices:        int(response.Vertices),
+		NilVertices:     int(response.NilVertices),
+		Edges:           int(response.Edges),
+		DegreeHistogram: buckets(response.DegreeHistogram),
+		TTLHistogram:    buckets(response.TtlHistogram),
+		MemoryBytes:     int64(response.MemoryBytes),
+	}
+	for _, d := range response.TopDegrees {
+		stats.TopDegrees = append(stats.TopDegrees, Degree{
+			Key: d.Key,
+			Out: int(d.OutDegree),
+			In:  int(d.InDegree),
+		})
+	}
+	return stats, nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// PutVertexWithSlidingTTL puts a value of key like PutVertex, whose expiration is extended to
+// slidingTTL after each read of the vertex. Zero slidingTTL uses the sliding TTL of the namespace.
+func (l *Lantern) PutVertexWithSlidingTTL(ctx context.Context, key string, value interface{}, ttl time.Duration, slidingTTL time.Duration) error {
+	v, err := nativeVertex{
+		key:        key,
+		value:      value,
+		expiration: time.Now().Add(ttl),
+	}.asVertex()
+	if err != nil {
+		return err
+	}
+	v.SlidingTtlSeconds = uint32(slidingTTL / time.Second)
+
+	request := &pb.PutVertexRequest{
+		Vertices: []*pb.Vertex{v},
+	}
+	if _, err := l.client.PutVertex(ctx, request); err != nil {
+		return translate(err, nil)
+	}
+	return nil
+}
+
+// AddEdgeWithSlidingTTL adds weight to an edge like AddEdge, whose expiration is extended to
+// slidingTTL after each read of the edge. Zero slidingTTL uses the sliding TTL of the namespace.
+func (l *Lantern) AddEdgeWithSlidingTTL(ctx context.Context, tail string, head string, weight float32, ttl time.Duration, slidingTTL time.Duration) error {
+	request := &pb.AddEdgeRequest{
+		Edges: []*pb.Edge{
+			{
+				Tail:              tail,
+				Head:              head,
+				Weight:            weight,
+				Expiration:        timestamppb.New(time.Now().Add(ttl)),
+				SlidingTtlSeconds: uint32(slidingTTL / time.Second),
+			},
+		},
+	}
+	if _, err := l.client.AddEdge(ctx, request); err != nil {
+		return translate(err, nil)
+	}
+	return nil
+}
+
+// Touch extends expirations of vertices of keys and of edges to ttl from now without rewriting
+// their values, or to the default TTL of the namespace if ttl is zero. Weights of edges are
+// ignored, and expirations are never shortened. Vertices and edges which do not exist are ignored.
+func (l *Lantern) Touch(ctx context.Context, keys []string, edges []*Edge, ttl time.Duration) error {
+	request := &pb.TouchRequest{
+		Keys:  keys,
+		Edges: make([]*pb.Edge, 0, len(edges)),
+	}
+	for _, e := range edges {
+		request.Edges = append(request.Edges, &pb.Edge{Tail: e.Tail, Head: e.Head})
+	}
+	if ttl != 0 {
+		request.Expiration = timestamppb.New(time.Now().Add(ttl))
+	}
+	if _, err := l.client.Touch(ctx, request); err != nil {
+		return translate(err, nil)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"testing"
+	"time"
+)
+
+// touchServer records the last request of Touch.
+type touchServer struct {
+	pb.UnimplementedLanternServiceServer
+	request *pb.TouchRequest
+}
+
+func (s *touchServer) Touch(ctx context.Context, request *pb.TouchRequest) (*pb.TouchResponse, error) {
+	s.request = request
+	return &pb.TouchResponse{Status: pb.Status_STATUS_OK}, nil
+}
+
+func TestLantern_Touch(t *testing.T) {
+	s := &touchServer{}
+	l := connect(t, startServer(t, s))
+
+	tests := []struct {
+		name           string
+		ttl            time.Duration
+		wantExpiration bool
+	}{
+		{name: "ttl", ttl: time.Hour, wantExpiration: true},
+		{name: "default ttl", ttl: 0, wantExpiration: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edges := []*Edge{{Tail: "a", Head: "b", Weight: 1}}
+			if err := l.Touch(context.Background(), []string{"a"}, edges, tt.ttl); err != nil {
+				t.Fatalf("Touch() error = %v", err)
+			}
+			r := s.request
+			if len(r.Keys) != 1 || len(r.Edges) != 1 || r.Edges[0].Tail != "a" || r.Edges[0].Weight != 0 {
+				t.Errorf("Touch() request = %v", r)
+			}
+			if (r.Expiration != nil) != tt.wantExpiration {
+				t.Errorf("Touch() expiration = %v, want set %v", r.Expiration, tt.wantExpiration)
+			}
+			if tt.wantExpiration && r.Expiration.AsTime().Before(time.Now().Add(59*time.Minute)) {
+				t.Errorf("Touch() expiration = %v, want an hour from now", r.Expiration.AsTime())
+			}
+		})
+	}
+}
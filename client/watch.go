@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"io"
+	"time"
+)
+
+type EventType int
+
+const (
+	EventPutVertex    = EventType(pb.EventType_EVENT_TYPE_PUT_VERTEX)
+	EventDeleteVertex = EventType(pb.EventType_EVENT_TYPE_DELETE_VERTEX)
+	EventExpireVertex = EventType(pb.EventType_EVENT_TYPE_EXPIRE_VERTEX)
+	EventAddEdge      = EventType(pb.EventType_EVENT_TYPE_ADD_EDGE)
+	EventPutEdge      = EventType(pb.EventType_EVENT_TYPE_PUT_EDGE)
+	EventDeleteEdge   = EventType(pb.EventType_EVENT_TYPE_DELETE_EDGE)
+	EventExpireEdge   = EventType(pb.EventType_EVENT_TYPE_EXPIRE_EDGE)
+	EventEvictVertex  = EventType(pb.EventType_EVENT_TYPE_EVICT_VERTEX)
+	EventEvictEdge    = EventType(pb.EventType_EVENT_TYPE_EVICT_EDGE)
+)
+
+func (t EventType) String() string {
+	return pb.EventType(t).String()
+}
+
+// Event is a change of a vertex or an edge.
+// Vertex is set for vertex events, and Tail, Head and Weight are set for edge events.
+// Weight is the one added, put or expired, not the total weight of the edge.
+type Event struct {
+	Type      EventType
+	Vertex    *Vertex
+	Tail      string
+	Head      string
+	Weight    float32
+	Timestamp time.Time
+}
+
+func newEvent(e *pb.Event) *Event {
+	event := &Event{
+		Type:      EventType(e.Type),
+		Timestamp: e.Timestamp.AsTime(),
+	}
+	if e.Vertex != nil {
+		event.Vertex = (*Vertex)(e.Vertex)
+	}
+	if e.Edge != nil {
+		event.Tail = e.Edge.Tail
+		event.Head = e.Edge.Head
+		event.Weight = e.Edge.Weight
+	}
+	return event
+}
+
+// Watcher receives events of a watch.
+type Watcher struct {
+	events chan *Event
+	err    error
+}
+
+// Events returns the channel of events, which is closed when the watch ends.
+func (w *Watcher) Events() <-chan *Event {
+	return w.events
+}
+
+// Err returns the error which ended the watch, e.g. ErrNamespaceNotFound when the namespace
+// is dropped, or nil if ctx is done or the server ended the stream. It must be called after
+// Events is closed.
+func (w *Watcher) Err() error {
+	return w.err
+}
+
+// Watch watches events of vertices and edges whose keys start with prefix.
+// An empty prefix watches all of them. The watch ends when ctx is done or the stream is
+// interrupted, and Watch should be called again to resume.
+func (l *Lantern) Watch(ctx context.Context, prefix string) (*Watcher, error) {
+	stream, err := l.client.Watch(ctx, &pb.WatchRequest{Prefix: prefix})
+	if err != nil {
+		return nil, translate(err, ErrNamespaceNotFound)
+	}
+
+	w := &Watcher{events: make(chan *Event)}
+	go func() {
+		defer close(w.events)
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					w.err = translate(err, ErrNamespaceNotFound)
+				}
+				return
+			}
+			select {
+			case w.events <- newEvent(response.Event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return w, nil
+}
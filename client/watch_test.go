@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	pb "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// watchServer sends an event of each key, and then ends the stream with err,
+// or waits for the client to cancel it if block is set.
+type watchServer struct {
+	pb.UnimplementedLanternServiceServer
+	keys  []string
+	err   error
+	block bool
+}
+
+func (s *watchServer) Watch(_ *pb.WatchRequest, stream pb.LanternService_WatchServer) error {
+	for _, key := range s.keys {
+		event := &pb.Event{Type: pb.EventType_EVENT_TYPE_PUT_VERTEX, Vertex: &pb.Vertex{Key: key}}
+		if err := stream.Send(&pb.WatchResponse{Event: event}); err != nil {
+			return err
+		}
+	}
+	if s.block {
+		<-stream.Context().Done()
+		return stream.Context().Err()
+	}
+	return s.err
+}
+
+func TestLantern_Watch(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantErr  error
+		wantCode codes.Code
+	}{
+		{name: "end of stream", err: nil, wantCode: codes.OK},
+		{name: "namespace dropped", err: status.Error(codes.NotFound, "namespace is dropped"), wantErr: ErrNamespaceNotFound, wantCode: codes.NotFound},
+		{name: "shutting down", err: status.Error(codes.Unavailable, "server is shutting down"), wantCode: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := connect(t, startServer(t, &watchServer{keys: []string{"a", "b"}, err: tt.err}))
+			w, err := l.Watch(context.Background(), "")
+			if err != nil {
+				t.Fatalf("Watch() error = %v", err)
+			}
+			var keys []string
+			for e := range w.Events() {
+				keys = append(keys, e.Vertex.Key)
+			}
+			if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+				t.Errorf("Events() = %v, want [a b]", keys)
+			}
+			if tt.wantErr != nil && !errors.Is(w.Err(), tt.wantErr) {
+				t.Errorf("Err() = %v, want %v", w.Err(), tt.wantErr)
+			}
+			if code := status.Code(w.Err()); code != tt.wantCode {
+				t.Errorf("status.Code(Err()) = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestLantern_Watch_canceled(t *testing.T) {
+	l := connect(t, startServer(t, &watchServer{keys: []string{"a"}, block: true}))
+	ctx, cancel := context.WithCancel(context.Background())
+	w, err := l.Watch(ctx, "")
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	if e := <-w.Events(); e == nil || e.Vertex.Key != "a" {
+		t.Fatalf("Events() = %v, want a", e)
+	}
+	cancel()
+	for range w.Events() {
+	}
+	if w.Err() != nil {
+		t.Errorf("Err() = %v, want nil", w.Err())
+	}
+}
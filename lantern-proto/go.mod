@@ -0,0 +1,17 @@
+module github.com/anaregdesign/lantern-proto
+
+go 1.20
+
+require (
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.15.2
+	google.golang.org/genproto v0.0.0-20230223222841-637eb2293923
+	google.golang.org/grpc v1.53.0
+	google.golang.org/protobuf v1.28.1
+)
+
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	golang.org/x/net v0.7.0 // indirect
+	golang.org/x/sys v0.5.0 // indirect
+	golang.org/x/text v0.7.0 // indirect
+)
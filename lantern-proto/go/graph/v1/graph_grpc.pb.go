@@ -0,0 +1,876 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: graph/v1/graph.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LanternService_Illuminate_FullMethodName      = "/graph.v1.LanternService/Illuminate"
+	LanternService_GetVertex_FullMethodName       = "/graph.v1.LanternService/GetVertex"
+	LanternService_GetVertices_FullMethodName     = "/graph.v1.LanternService/GetVertices"
+	LanternService_PutVertex_FullMethodName       = "/graph.v1.LanternService/PutVertex"
+	LanternService_DeleteVertex_FullMethodName    = "/graph.v1.LanternService/DeleteVertex"
+	LanternService_GetEdge_FullMethodName         = "/graph.v1.LanternService/GetEdge"
+	LanternService_ListOutEdges_FullMethodName    = "/graph.v1.LanternService/ListOutEdges"
+	LanternService_ListInEdges_FullMethodName     = "/graph.v1.LanternService/ListInEdges"
+	LanternService_ScanVertices_FullMethodName    = "/graph.v1.LanternService/ScanVertices"
+	LanternService_Stats_FullMethodName           = "/graph.v1.LanternService/Stats"
+	LanternService_AddEdge_FullMethodName         = "/graph.v1.LanternService/AddEdge"
+	LanternService_PutEdge_FullMethodName         = "/graph.v1.LanternService/PutEdge"
+	LanternService_DeleteEdge_FullMethodName      = "/graph.v1.LanternService/DeleteEdge"
+	LanternService_Touch_FullMethodName           = "/graph.v1.LanternService/Touch"
+	LanternService_Watch_FullMethodName           = "/graph.v1.LanternService/Watch"
+	LanternService_Ingest_FullMethodName          = "/graph.v1.LanternService/Ingest"
+	LanternService_CreateNamespace_FullMethodName = "/graph.v1.LanternService/CreateNamespace"
+	LanternService_ListNamespaces_FullMethodName  = "/graph.v1.LanternService/ListNamespaces"
+	LanternService_FlushNamespace_FullMethodName  = "/graph.v1.LanternService/FlushNamespace"
+	LanternService_DropNamespace_FullMethodName   = "/graph.v1.LanternService/DropNamespace"
+)
+
+// LanternServiceClient is the client API for LanternService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LanternServiceClient interface {
+	Illuminate(ctx context.Context, in *IlluminateRequest, opts ...grpc.CallOption) (*IlluminateResponse, error)
+	GetVertex(ctx context.Context, in *GetVertexRequest, opts ...grpc.CallOption) (*GetVertexResponse, error)
+	GetVertices(ctx context.Context, in *GetVerticesRequest, opts ...grpc.CallOption) (*GetVerticesResponse, error)
+	PutVertex(ctx context.Context, in *PutVertexRequest, opts ...grpc.CallOption) (*PutVertexResponse, error)
+	DeleteVertex(ctx context.Context, in *DeleteVertexRequest, opts ...grpc.CallOption) (*DeleteVertexResponse, error)
+	GetEdge(ctx context.Context, in *GetEdgeRequest, opts ...grpc.CallOption) (*GetEdgeResponse, error)
+	ListOutEdges(ctx context.Context, in *ListEdgesRequest, opts ...grpc.CallOption) (*ListEdgesResponse, error)
+	ListInEdges(ctx context.Context, in *ListEdgesRequest, opts ...grpc.CallOption) (*ListEdgesResponse, error)
+	ScanVertices(ctx context.Context, in *ScanVerticesRequest, opts ...grpc.CallOption) (*ScanVerticesResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	AddEdge(ctx context.Context, in *AddEdgeRequest, opts ...grpc.CallOption) (*AddEdgeResponse, error)
+	PutEdge(ctx context.Context, in *PutEdgeRequest, opts ...grpc.CallOption) (*PutEdgeResponse, error)
+	DeleteEdge(ctx context.Context, in *DeleteEdgeRequest, opts ...grpc.CallOption) (*DeleteEdgeResponse, error)
+	// Touch refreshes TTLs of vertices and edges without rewriting their values.
+	Touch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*TouchResponse, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (LanternService_WatchClient, error)
+	Ingest(ctx context.Context, opts ...grpc.CallOption) (LanternService_IngestClient, error)
+	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	// FlushNamespace removes all vertices and edges of a namespace, keeping the namespace itself.
+	FlushNamespace(ctx context.Context, in *FlushNamespaceRequest, opts ...grpc.CallOption) (*FlushNamespaceResponse, error)
+	DropNamespace(ctx context.Context, in *DropNamespaceRequest, opts ...grpc.CallOption) (*DropNamespaceResponse, error)
+}
+
+type lanternServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLanternServiceClient(cc grpc.ClientConnInterface) LanternServiceClient {
+	return &lanternServiceClient{cc}
+}
+
+func (c *lanternServiceClient) Illuminate(ctx context.Context, in *IlluminateRequest, opts ...grpc.CallOption) (*IlluminateResponse, error) {
+	out := new(IlluminateResponse)
+	err := c.cc.Invoke(ctx, LanternService_Illuminate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) GetVertex(ctx context.Context, in *GetVertexRequest, opts ...grpc.CallOption) (*GetVertexResponse, error) {
+	out := new(GetVertexResponse)
+	err := c.cc.Invoke(ctx, LanternService_GetVertex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) GetVertices(ctx context.Context, in *GetVerticesRequest, opts ...grpc.CallOption) (*GetVerticesResponse, error) {
+	out := new(GetVerticesResponse)
+	err := c.cc.Invoke(ctx, LanternService_GetVertices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) PutVertex(ctx context.Context, in *PutVertexRequest, opts ...grpc.CallOption) (*PutVertexResponse, error) {
+	out := new(PutVertexResponse)
+	err := c.cc.Invoke(ctx, LanternService_PutVertex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) DeleteVertex(ctx context.Context, in *DeleteVertexRequest, opts ...grpc.CallOption) (*DeleteVertexResponse, error) {
+	out := new(DeleteVertexResponse)
+	err := c.cc.Invoke(ctx, LanternService_DeleteVertex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) GetEdge(ctx context.Context, in *GetEdgeRequest, opts ...grpc.CallOption) (*GetEdgeResponse, error) {
+	out := new(GetEdgeResponse)
+	err := c.cc.Invoke(ctx, LanternService_GetEdge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) ListOutEdges(ctx context.Context, in *ListEdgesRequest, opts ...grpc.CallOption) (*ListEdgesResponse, error) {
+	out := new(ListEdgesResponse)
+	err := c.cc.Invoke(ctx, LanternService_ListOutEdges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) ListInEdges(ctx context.Context, in *ListEdgesRequest, opts ...grpc.CallOption) (*ListEdgesResponse, error) {
+	out := new(ListEdgesResponse)
+	err := c.cc.Invoke(ctx, LanternService_ListInEdges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) ScanVertices(ctx context.Context, in *ScanVerticesRequest, opts ...grpc.CallOption) (*ScanVerticesResponse, error) {
+	out := new(ScanVerticesResponse)
+	err := c.cc.Invoke(ctx, LanternService_ScanVertices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, LanternService_Stats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) AddEdge(ctx context.Context, in *AddEdgeRequest, opts ...grpc.CallOption) (*AddEdgeResponse, error) {
+	out := new(AddEdgeResponse)
+	err := c.cc.Invoke(ctx, LanternService_AddEdge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) PutEdge(ctx context.Context, in *PutEdgeRequest, opts ...grpc.CallOption) (*PutEdgeResponse, error) {
+	out := new(PutEdgeResponse)
+	err := c.cc.Invoke(ctx, LanternService_PutEdge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) DeleteEdge(ctx context.Context, in *DeleteEdgeRequest, opts ...grpc.CallOption) (*DeleteEdgeResponse, error) {
+	out := new(DeleteEdgeResponse)
+	err := c.cc.Invoke(ctx, LanternService_DeleteEdge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) Touch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*TouchResponse, error) {
+	out := new(TouchResponse)
+	err := c.cc.Invoke(ctx, LanternService_Touch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (LanternService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LanternService_ServiceDesc.Streams[0], LanternService_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lanternServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LanternService_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type lanternServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *lanternServiceWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lanternServiceClient) Ingest(ctx context.Context, opts ...grpc.CallOption) (LanternService_IngestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LanternService_ServiceDesc.Streams[1], LanternService_Ingest_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lanternServiceIngestClient{stream}
+	return x, nil
+}
+
+type LanternService_IngestClient interface {
+	Send(*IngestRequest) error
+	Recv() (*IngestResponse, error)
+	grpc.ClientStream
+}
+
+type lanternServiceIngestClient struct {
+	grpc.ClientStream
+}
+
+func (x *lanternServiceIngestClient) Send(m *IngestRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lanternServiceIngestClient) Recv() (*IngestResponse, error) {
+	m := new(IngestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lanternServiceClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error) {
+	out := new(CreateNamespaceResponse)
+	err := c.cc.Invoke(ctx, LanternService_CreateNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, LanternService_ListNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) FlushNamespace(ctx context.Context, in *FlushNamespaceRequest, opts ...grpc.CallOption) (*FlushNamespaceResponse, error) {
+	out := new(FlushNamespaceResponse)
+	err := c.cc.Invoke(ctx, LanternService_FlushNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lanternServiceClient) DropNamespace(ctx context.Context, in *DropNamespaceRequest, opts ...grpc.CallOption) (*DropNamespaceResponse, error) {
+	out := new(DropNamespaceResponse)
+	err := c.cc.Invoke(ctx, LanternService_DropNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LanternServiceServer is the server API for LanternService service.
+// All implementations must embed UnimplementedLanternServiceServer
+// for forward compatibility
+type LanternServiceServer interface {
+	Illuminate(context.Context, *IlluminateRequest) (*IlluminateResponse, error)
+	GetVertex(context.Context, *GetVertexRequest) (*GetVertexResponse, error)
+	GetVertices(context.Context, *GetVerticesRequest) (*GetVerticesResponse, error)
+	PutVertex(context.Context, *PutVertexRequest) (*PutVertexResponse, error)
+	DeleteVertex(context.Context, *DeleteVertexRequest) (*DeleteVertexResponse, error)
+	GetEdge(context.Context, *GetEdgeRequest) (*GetEdgeResponse, error)
+	ListOutEdges(context.Context, *ListEdgesRequest) (*ListEdgesResponse, error)
+	ListInEdges(context.Context, *ListEdgesRequest) (*ListEdgesResponse, error)
+	ScanVertices(context.Context, *ScanVerticesRequest) (*ScanVerticesResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	AddEdge(context.Context, *AddEdgeRequest) (*AddEdgeResponse, error)
+	PutEdge(context.Context, *PutEdgeRequest) (*PutEdgeResponse, error)
+	DeleteEdge(context.Context, *DeleteEdgeRequest) (*DeleteEdgeResponse, error)
+	// Touch refreshes TTLs of vertices and edges without rewriting their values.
+	Touch(context.Context, *TouchRequest) (*TouchResponse, error)
+	Watch(*WatchRequest, LanternService_WatchServer) error
+	Ingest(LanternService_IngestServer) error
+	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	// FlushNamespace removes all vertices and edges of a namespace, keeping the namespace itself.
+	FlushNamespace(context.Context, *FlushNamespaceRequest) (*FlushNamespaceResponse, error)
+	DropNamespace(context.Context, *DropNamespaceRequest) (*DropNamespaceResponse, error)
+	mustEmbedUnimplementedLanternServiceServer()
+}
+
+// UnimplementedLanternServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLanternServiceServer struct {
+}
+
+func (UnimplementedLanternServiceServer) Illuminate(context.Context, *IlluminateRequest) (*IlluminateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Illuminate not implemented")
+}
+func (UnimplementedLanternServiceServer) GetVertex(context.Context, *GetVertexRequest) (*GetVertexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVertex not implemented")
+}
+func (UnimplementedLanternServiceServer) GetVertices(context.Context, *GetVerticesRequest) (*GetVerticesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVertices not implemented")
+}
+func (UnimplementedLanternServiceServer) PutVertex(context.Context, *PutVertexRequest) (*PutVertexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutVertex not implemented")
+}
+func (UnimplementedLanternServiceServer) DeleteVertex(context.Context, *DeleteVertexRequest) (*DeleteVertexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVertex not implemented")
+}
+func (UnimplementedLanternServiceServer) GetEdge(context.Context, *GetEdgeRequest) (*GetEdgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEdge not implemented")
+}
+func (UnimplementedLanternServiceServer) ListOutEdges(context.Context, *ListEdgesRequest) (*ListEdgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutEdges not implemented")
+}
+func (UnimplementedLanternServiceServer) ListInEdges(context.Context, *ListEdgesRequest) (*ListEdgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInEdges not implemented")
+}
+func (UnimplementedLanternServiceServer) ScanVertices(context.Context, *ScanVerticesRequest) (*ScanVerticesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanVertices not implemented")
+}
+func (UnimplementedLanternServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedLanternServiceServer) AddEdge(context.Context, *AddEdgeRequest) (*AddEdgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEdge not implemented")
+}
+func (UnimplementedLanternServiceServer) PutEdge(context.Context, *PutEdgeRequest) (*PutEdgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutEdge not implemented")
+}
+func (UnimplementedLanternServiceServer) DeleteEdge(context.Context, *DeleteEdgeRequest) (*DeleteEdgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEdge not implemented")
+}
+func (UnimplementedLanternServiceServer) Touch(context.Context, *TouchRequest) (*TouchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Touch not implemented")
+}
+func (UnimplementedLanternServiceServer) Watch(*WatchRequest, LanternService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedLanternServiceServer) Ingest(LanternService_IngestServer) error {
+	return status.Errorf(codes.Unimplemented, "method Ingest not implemented")
+}
+func (UnimplementedLanternServiceServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedLanternServiceServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedLanternServiceServer) FlushNamespace(context.Context, *FlushNamespaceRequest) (*FlushNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushNamespace not implemented")
+}
+func (UnimplementedLanternServiceServer) DropNamespace(context.Context, *DropNamespaceRequest) (*DropNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropNamespace not implemented")
+}
+func (UnimplementedLanternServiceServer) mustEmbedUnimplementedLanternServiceServer() {}
+
+// UnsafeLanternServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LanternServiceServer will
+// result in compilation errors.
+type UnsafeLanternServiceServer interface {
+	mustEmbedUnimplementedLanternServiceServer()
+}
+
+func RegisterLanternServiceServer(s grpc.ServiceRegistrar, srv LanternServiceServer) {
+	s.RegisterService(&LanternService_ServiceDesc, srv)
+}
+
+func _LanternService_Illuminate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IlluminateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).Illuminate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_Illuminate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).Illuminate(ctx, req.(*IlluminateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_GetVertex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVertexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).GetVertex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_GetVertex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).GetVertex(ctx, req.(*GetVertexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_GetVertices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerticesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).GetVertices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_GetVertices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).GetVertices(ctx, req.(*GetVerticesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_PutVertex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutVertexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).PutVertex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_PutVertex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).PutVertex(ctx, req.(*PutVertexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_DeleteVertex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVertexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).DeleteVertex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_DeleteVertex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).DeleteVertex(ctx, req.(*DeleteVertexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_GetEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).GetEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_GetEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).GetEdge(ctx, req.(*GetEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_ListOutEdges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEdgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).ListOutEdges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_ListOutEdges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).ListOutEdges(ctx, req.(*ListEdgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_ListInEdges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEdgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).ListInEdges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_ListInEdges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).ListInEdges(ctx, req.(*ListEdgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_ScanVertices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanVerticesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).ScanVertices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_ScanVertices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).ScanVertices(ctx, req.(*ScanVerticesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_AddEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).AddEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_AddEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).AddEdge(ctx, req.(*AddEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_PutEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).PutEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_PutEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).PutEdge(ctx, req.(*PutEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_DeleteEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEdgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).DeleteEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_DeleteEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).DeleteEdge(ctx, req.(*DeleteEdgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_Touch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).Touch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_Touch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).Touch(ctx, req.(*TouchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LanternServiceServer).Watch(m, &lanternServiceWatchServer{stream})
+}
+
+type LanternService_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type lanternServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *lanternServiceWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LanternService_Ingest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LanternServiceServer).Ingest(&lanternServiceIngestServer{stream})
+}
+
+type LanternService_IngestServer interface {
+	Send(*IngestResponse) error
+	Recv() (*IngestRequest, error)
+	grpc.ServerStream
+}
+
+type lanternServiceIngestServer struct {
+	grpc.ServerStream
+}
+
+func (x *lanternServiceIngestServer) Send(m *IngestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lanternServiceIngestServer) Recv() (*IngestRequest, error) {
+	m := new(IngestRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LanternService_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_CreateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).CreateNamespace(ctx, req.(*CreateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_FlushNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).FlushNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_FlushNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).FlushNamespace(ctx, req.(*FlushNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LanternService_DropNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanternServiceServer).DropNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanternService_DropNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanternServiceServer).DropNamespace(ctx, req.(*DropNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LanternService_ServiceDesc is the grpc.ServiceDesc for LanternService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LanternService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "graph.v1.LanternService",
+	HandlerType: (*LanternServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Illuminate",
+			Handler:    _LanternService_Illuminate_Handler,
+		},
+		{
+			MethodName: "GetVertex",
+			Handler:    _LanternService_GetVertex_Handler,
+		},
+		{
+			MethodName: "GetVertices",
+			Handler:    _LanternService_GetVertices_Handler,
+		},
+		{
+			MethodName: "PutVertex",
+			Handler:    _LanternService_PutVertex_Handler,
+		},
+		{
+			MethodName: "DeleteVertex",
+			Handler:    _LanternService_DeleteVertex_Handler,
+		},
+		{
+			MethodName: "GetEdge",
+			Handler:    _LanternService_GetEdge_Handler,
+		},
+		{
+			MethodName: "ListOutEdges",
+			Handler:    _LanternService_ListOutEdges_Handler,
+		},
+		{
+			MethodName: "ListInEdges",
+			Handler:    _LanternService_ListInEdges_Handler,
+		},
+		{
+			MethodName: "ScanVertices",
+			Handler:    _LanternService_ScanVertices_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _LanternService_Stats_Handler,
+		},
+		{
+			MethodName: "AddEdge",
+			Handler:    _LanternService_AddEdge_Handler,
+		},
+		{
+			MethodName: "PutEdge",
+			Handler:    _LanternService_PutEdge_Handler,
+		},
+		{
+			MethodName: "DeleteEdge",
+			Handler:    _LanternService_DeleteEdge_Handler,
+		},
+		{
+			MethodName: "Touch",
+			Handler:    _LanternService_Touch_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _LanternService_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _LanternService_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "FlushNamespace",
+			Handler:    _LanternService_FlushNamespace_Handler,
+		},
+		{
+			MethodName: "DropNamespace",
+			Handler:    _LanternService_DropNamespace_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _LanternService_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Ingest",
+			Handler:       _LanternService_Ingest_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "graph/v1/graph.proto",
+}
@@ -0,0 +1,677 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: graph/v1/replication.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Mutation is an entry of the mutation log of a leader. Requests to create, flush and drop a
+// namespace name it themselves, and the other requests are applied to namespace, or to the
+// default namespace if it is empty.
+type Mutation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Types that are assignable to Request:
+	//
+	//	*Mutation_PutVertex
+	//	*Mutation_DeleteVertex
+	//	*Mutation_AddEdge
+	//	*Mutation_PutEdge
+	//	*Mutation_DeleteEdge
+	//	*Mutation_Touch
+	//	*Mutation_Ingest
+	//	*Mutation_CreateNamespace
+	//	*Mutation_FlushNamespace
+	//	*Mutation_DropNamespace
+	Request isMutation_Request `protobuf_oneof:"request"`
+}
+
+func (x *Mutation) Reset() {
+	*x = Mutation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graph_v1_replication_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mutation) ProtoMessage() {}
+
+func (x *Mutation) ProtoReflect() protoreflect.Message {
+	mi := &file_graph_v1_replication_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mutation.ProtoReflect.Descriptor instead.
+func (*Mutation) Descriptor() ([]byte, []int) {
+	return file_graph_v1_replication_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Mutation) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Mutation) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (m *Mutation) GetRequest() isMutation_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *Mutation) GetPutVertex() *PutVertexRequest {
+	if x, ok := x.GetRequest().(*Mutation_PutVertex); ok {
+		return x.PutVertex
+	}
+	return nil
+}
+
+func (x *Mutation) GetDeleteVertex() *DeleteVertexRequest {
+	if x, ok := x.GetRequest().(*Mutation_DeleteVertex); ok {
+		return x.DeleteVertex
+	}
+	return nil
+}
+
+func (x *Mutation) GetAddEdge() *AddEdgeRequest {
+	if x, ok := x.GetRequest().(*Mutation_AddEdge); ok {
+		return x.AddEdge
+	}
+	return nil
+}
+
+func (x *Mutation) GetPutEdge() *PutEdgeRequest {
+	if x, ok := x.GetRequest().(*Mutation_PutEdge); ok {
+		return x.PutEdge
+	}
+	return nil
+}
+
+func (x *Mutation) GetDeleteEdge() *DeleteEdgeRequest {
+	if x, ok := x.GetRequest().(*Mutation_DeleteEdge); ok {
+		return x.DeleteEdge
+	}
+	return nil
+}
+
+func (x *Mutation) GetTouch() *TouchRequest {
+	if x, ok := x.GetRequest().(*Mutation_Touch); ok {
+		return x.Touch
+	}
+	return nil
+}
+
+func (x *Mutation) GetIngest() *IngestRequest {
+	if x, ok := x.GetRequest().(*Mutation_Ingest); ok {
+		return x.Ingest
+	}
+	return nil
+}
+
+func (x *Mutation) GetCreateNamespace() *CreateNamespaceRequest {
+	if x, ok := x.GetRequest().(*Mutation_CreateNamespace); ok {
+		return x.CreateNamespace
+	}
+	return nil
+}
+
+func (x *Mutation) GetFlushNamespace() *FlushNamespaceRequest {
+	if x, ok := x.GetRequest().(*Mutation_FlushNamespace); ok {
+		return x.FlushNamespace
+	}
+	return nil
+}
+
+func (x *Mutation) GetDropNamespace() *DropNamespaceRequest {
+	if x, ok := x.GetRequest().(*Mutation_DropNamespace); ok {
+		return x.DropNamespace
+	}
+	return nil
+}
+
+type isMutation_Request interface {
+	isMutation_Request()
+}
+
+type Mutation_PutVertex struct {
+	PutVertex *PutVertexRequest `protobuf:"bytes,10,opt,name=put_vertex,json=putVertex,proto3,oneof"`
+}
+
+type Mutation_DeleteVertex struct {
+	DeleteVertex *DeleteVertexRequest `protobuf:"bytes,11,opt,name=delete_vertex,json=deleteVertex,proto3,oneof"`
+}
+
+type Mutation_AddEdge struct {
+	AddEdge *AddEdgeRequest `protobuf:"bytes,12,opt,name=add_edge,json=addEdge,proto3,oneof"`
+}
+
+type Mutation_PutEdge struct {
+	PutEdge *PutEdgeRequest `protobuf:"bytes,13,opt,name=put_edge,json=putEdge,proto3,oneof"`
+}
+
+type Mutation_DeleteEdge struct {
+	DeleteEdge *DeleteEdgeRequest `protobuf:"bytes,14,opt,name=delete_edge,json=deleteEdge,proto3,oneof"`
+}
+
+type Mutation_Touch struct {
+	Touch *TouchRequest `protobuf:"bytes,15,opt,name=touch,proto3,oneof"`
+}
+
+type Mutation_Ingest struct {
+	Ingest *IngestRequest `protobuf:"bytes,16,opt,name=ingest,proto3,oneof"`
+}
+
+type Mutation_CreateNamespace struct {
+	CreateNamespace *CreateNamespaceRequest `protobuf:"bytes,17,opt,name=create_namespace,json=createNamespace,proto3,oneof"`
+}
+
+type Mutation_FlushNamespace struct {
+	FlushNamespace *FlushNamespaceRequest `protobuf:"bytes,18,opt,name=flush_namespace,json=flushNamespace,proto3,oneof"`
+}
+
+type Mutation_DropNamespace struct {
+	DropNamespace *DropNamespaceRequest `protobuf:"bytes,19,opt,name=drop_namespace,json=dropNamespace,proto3,oneof"`
+}
+
+func (*Mutation_PutVertex) isMutation_Request() {}
+
+func (*Mutation_DeleteVertex) isMutation_Request() {}
+
+func (*Mutation_AddEdge) isMutation_Request() {}
+
+func (*Mutation_PutEdge) isMutation_Request() {}
+
+func (*Mutation_DeleteEdge) isMutation_Request() {}
+
+func (*Mutation_Touch) isMutation_Request() {}
+
+func (*Mutation_Ingest) isMutation_Request() {}
+
+func (*Mutation_CreateNamespace) isMutation_Request() {}
+
+func (*Mutation_FlushNamespace) isMutation_Request() {}
+
+func (*Mutation_DropNamespace) isMutation_Request() {}
+
+// SnapshotChunk is a part of a snapshot which contains all mutations up to sequence.
+// A follower discards its graphs on the first chunk, and resumes from sequence after the last chunk.
+// graph belongs to namespace, which is created unless it exists, or to the default namespace if
+// namespace is not set. A namespace without vertices and edges is sent in a chunk of an empty graph.
+type SnapshotChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  uint64     `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Graph     *Graph     `protobuf:"bytes,2,opt,name=graph,proto3" json:"graph,omitempty"`
+	First     bool       `protobuf:"varint,3,opt,name=first,proto3" json:"first,omitempty"`
+	Last      bool       `protobuf:"varint,4,opt,name=last,proto3" json:"last,omitempty"`
+	Namespace *Namespace `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *SnapshotChunk) Reset() {
+	*x = SnapshotChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graph_v1_replication_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotChunk) ProtoMessage() {}
+
+func (x *SnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_graph_v1_replication_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotChunk.ProtoReflect.Descriptor instead.
+func (*SnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_graph_v1_replication_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SnapshotChunk) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SnapshotChunk) GetGraph() *Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *SnapshotChunk) GetFirst() bool {
+	if x != nil {
+		return x.First
+	}
+	return false
+}
+
+func (x *SnapshotChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *SnapshotChunk) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type ReplicateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence of the last mutation the follower has applied.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *ReplicateRequest) Reset() {
+	*x = ReplicateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graph_v1_replication_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateRequest) ProtoMessage() {}
+
+func (x *ReplicateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graph_v1_replication_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateRequest.ProtoReflect.Descriptor instead.
+func (*ReplicateRequest) Descriptor() ([]byte, []int) {
+	return file_graph_v1_replication_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReplicateRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type ReplicateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*ReplicateResponse_Snapshot
+	//	*ReplicateResponse_Mutation
+	Event isReplicateResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *ReplicateResponse) Reset() {
+	*x = ReplicateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graph_v1_replication_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateResponse) ProtoMessage() {}
+
+func (x *ReplicateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graph_v1_replication_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateResponse.ProtoReflect.Descriptor instead.
+func (*ReplicateResponse) Descriptor() ([]byte, []int) {
+	return file_graph_v1_replication_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *ReplicateResponse) GetEvent() isReplicateResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *ReplicateResponse) GetSnapshot() *SnapshotChunk {
+	if x, ok := x.GetEvent().(*ReplicateResponse_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *ReplicateResponse) GetMutation() *Mutation {
+	if x, ok := x.GetEvent().(*ReplicateResponse_Mutation); ok {
+		return x.Mutation
+	}
+	return nil
+}
+
+type isReplicateResponse_Event interface {
+	isReplicateResponse_Event()
+}
+
+type ReplicateResponse_Snapshot struct {
+	Snapshot *SnapshotChunk `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type ReplicateResponse_Mutation struct {
+	Mutation *Mutation `protobuf:"bytes,2,opt,name=mutation,proto3,oneof"`
+}
+
+func (*ReplicateResponse_Snapshot) isReplicateResponse_Event() {}
+
+func (*ReplicateResponse_Mutation) isReplicateResponse_Event() {}
+
+var File_graph_v1_replication_proto protoreflect.FileDescriptor
+
+var file_graph_v1_replication_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x05, 0x0a,
+	0x08, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x75, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x70, 0x75, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x12, 0x44, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x65, 0x64,
+	0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x45, 0x64, 0x67, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x70, 0x75, 0x74, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x45, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x75, 0x74,
+	0x45, 0x64, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x65,
+	0x64, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x64, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x75, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74,
+	0x6f, 0x75, 0x63, 0x68, 0x12, 0x31, 0x0a, 0x06, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x06, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x72,
+	0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x32, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x26,
+	0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_graph_v1_replication_proto_rawDescOnce sync.Once
+	file_graph_v1_replication_proto_rawDescData = file_graph_v1_replication_proto_rawDesc
+)
+
+func file_graph_v1_replication_proto_rawDescGZIP() []byte {
+	file_graph_v1_replication_proto_rawDescOnce.Do(func() {
+		file_graph_v1_replication_proto_rawDescData = protoimpl.X.CompressGZIP(file_graph_v1_replication_proto_rawDescData)
+	})
+	return file_graph_v1_replication_proto_rawDescData
+}
+
+var file_graph_v1_replication_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_graph_v1_replication_proto_goTypes = []interface{}{
+	(*Mutation)(nil),               // 0: graph.v1.Mutation
+	(*SnapshotChunk)(nil),          // 1: graph.v1.SnapshotChunk
+	(*ReplicateRequest)(nil),       // 2: graph.v1.ReplicateRequest
+	(*ReplicateResponse)(nil),      // 3: graph.v1.ReplicateResponse
+	(*PutVertexRequest)(nil),       // 4: graph.v1.PutVertexRequest
+	(*DeleteVertexRequest)(nil),    // 5: graph.v1.DeleteVertexRequest
+	(*AddEdgeRequest)(nil),         // 6: graph.v1.AddEdgeRequest
+	(*PutEdgeRequest)(nil),         // 7: graph.v1.PutEdgeRequest
+	(*DeleteEdgeRequest)(nil),      // 8: graph.v1.DeleteEdgeRequest
+	(*TouchRequest)(nil),           // 9: graph.v1.TouchRequest
+	(*IngestRequest)(nil),          // 10: graph.v1.IngestRequest
+	(*CreateNamespaceRequest)(nil), // 11: graph.v1.CreateNamespaceRequest
+	(*FlushNamespaceRequest)(nil),  // 12: graph.v1.FlushNamespaceRequest
+	(*DropNamespaceRequest)(nil),   // 13: graph.v1.DropNamespaceRequest
+	(*Graph)(nil),                  // 14: graph.v1.Graph
+	(*Namespace)(nil),              // 15: graph.v1.Namespace
+}
+var file_graph_v1_replication_proto_depIdxs = []int32{
+	4,  // 0: graph.v1.Mutation.put_vertex:type_name -> graph.v1.PutVertexRequest
+	5,  // 1: graph.v1.Mutation.delete_vertex:type_name -> graph.v1.DeleteVertexRequest
+	6,  // 2: graph.v1.Mutation.add_edge:type_name -> graph.v1.AddEdgeRequest
+	7,  // 3: graph.v1.Mutation.put_edge:type_name -> graph.v1.PutEdgeRequest
+	8,  // 4: graph.v1.Mutation.delete_edge:type_name -> graph.v1.DeleteEdgeRequest
+	9,  // 5: graph.v1.Mutation.touch:type_name -> graph.v1.TouchRequest
+	10, // 6: graph.v1.Mutation.ingest:type_name -> graph.v1.IngestRequest
+	11, // 7: graph.v1.Mutation.create_namespace:type_name -> graph.v1.CreateNamespaceRequest
+	12, // 8: graph.v1.Mutation.flush_namespace:type_name -> graph.v1.FlushNamespaceRequest
+	13, // 9: graph.v1.Mutation.drop_namespace:type_name -> graph.v1.DropNamespaceRequest
+	14, // 10: graph.v1.SnapshotChunk.graph:type_name -> graph.v1.Graph
+	15, // 11: graph.v1.SnapshotChunk.namespace:type_name -> graph.v1.Namespace
+	1,  // 12: graph.v1.ReplicateResponse.snapshot:type_name -> graph.v1.SnapshotChunk
+	0,  // 13: graph.v1.ReplicateResponse.mutation:type_name -> graph.v1.Mutation
+	2,  // 14: graph.v1.ReplicationService.Replicate:input_type -> graph.v1.ReplicateRequest
+	3,  // 15: graph.v1.ReplicationService.Replicate:output_type -> graph.v1.ReplicateResponse
+	15, // [15:16] is the sub-list for method output_type
+	14, // [14:15] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_graph_v1_replication_proto_init() }
+func file_graph_v1_replication_proto_init() {
+	if File_graph_v1_replication_proto != nil {
+		return
+	}
+	file_graph_v1_graph_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_graph_v1_replication_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mutation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graph_v1_replication_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graph_v1_replication_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graph_v1_replication_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_graph_v1_replication_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Mutation_PutVertex)(nil),
+		(*Mutation_DeleteVertex)(nil),
+		(*Mutation_AddEdge)(nil),
+		(*Mutation_PutEdge)(nil),
+		(*Mutation_DeleteEdge)(nil),
+		(*Mutation_Touch)(nil),
+		(*Mutation_Ingest)(nil),
+		(*Mutation_CreateNamespace)(nil),
+		(*Mutation_FlushNamespace)(nil),
+		(*Mutation_DropNamespace)(nil),
+	}
+	file_graph_v1_replication_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*ReplicateResponse_Snapshot)(nil),
+		(*ReplicateResponse_Mutation)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_graph_v1_replication_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_graph_v1_replication_proto_goTypes,
+		DependencyIndexes: file_graph_v1_replication_proto_depIdxs,
+		MessageInfos:      file_graph_v1_replication_proto_msgTypes,
+	}.Build()
+	File_graph_v1_replication_proto = out.File
+	file_graph_v1_replication_proto_rawDesc = nil
+	file_graph_v1_replication_proto_goTypes = nil
+	file_graph_v1_replication_proto_depIdxs = nil
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"strings"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrUnknownRole  = errors.New("unknown role")
+)
+
+// Role is a right granted to a principal. Each role includes the rights of lower roles.
+type Role int
+
+const (
+	Read Role = iota + 1
+	Write
+	Admin
+)
+
+var roles = map[string]Role{
+	"read":  Read,
+	"write": Write,
+	"admin": Admin,
+}
+
+func ParseRole(s string) (Role, error) {
+	if r, ok := roles[strings.ToLower(s)]; ok {
+		return r, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownRole, s)
+}
+
+func (r Role) String() string {
+	switch r {
+	case Read:
+		return "read"
+	case Write:
+		return "write"
+	case Admin:
+		return "admin"
+	default:
+		return "none"
+	}
+}
+
+func (r *Role) UnmarshalText(b []byte) error {
+	role, err := ParseRole(string(b))
+	if err != nil {
+		return err
+	}
+	*r = role
+	return nil
+}
+
+func (r Role) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// AllNamespaces is a namespace of a grant which covers every namespace.
+const AllNamespaces = "*"
+
+// Grant gives Role on keys starting with Prefix in Namespace. An empty prefix covers all
+// keys, and an empty namespace stands for the default namespace.
+type Grant struct {
+	Namespace string `json:"namespace,omitempty"`
+	Prefix    string `json:"prefix"`
+	Role      Role   `json:"role"`
+}
+
+func (g Grant) covers(ns string) bool {
+	switch g.Namespace {
+	case AllNamespaces:
+		return true
+	case "":
+		return ns == namespace.Default
+	default:
+		return ns == g.Namespace
+	}
+}
+
+// Principal is an authenticated caller.
+type Principal struct {
+	Name   string  `json:"name"`
+	Grants []Grant `json:"grants"`
+}
+
+// Allows reports whether p has role on key in namespace ns. Since a grant on a prefix covers
+// every key starting with it, a key also stands for the set of keys starting with it, e.g.
+// the prefix of ScanVertices.
+func (p *Principal) Allows(role Role, ns string, key string) bool {
+	for _, g := range p.Grants {
+		if g.Role >= role && g.covers(ns) && strings.HasPrefix(key, g.Prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticator returns the principal identified by a bearer token, or ErrInvalidToken.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+// Chain tries each authenticator in order, and returns the first principal found.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	for _, a := range c {
+		p, err := a.Authenticate(ctx, token)
+		if errors.Is(err, ErrInvalidToken) {
+			continue
+		}
+		return p, err
+	}
+	return nil, ErrInvalidToken
+}
+
+type principalKey struct{}
+
+// NewContext returns a context carrying p.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of ctx, or nil if it is not authenticated.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// BearerToken is a credential attaching a token to each RPC, e.g. of a node to other nodes.
+// It is sent without transport security, so the token should be protected by TLS in production.
+type BearerToken string
+
+func (t BearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t BearerToken) RequireTransportSecurity() bool {
+	return false
+}
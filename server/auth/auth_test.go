@@ -0,0 +1,320 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/cluster"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, name string, b []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPrincipal_Allows(t *testing.T) {
+	p := &Principal{Name: "p", Grants: []Grant{
+		{Prefix: "user:", Role: Write},
+		{Prefix: "item:", Role: Read},
+		{Namespace: "fraud", Prefix: "", Role: Admin},
+		{Namespace: AllNamespaces, Prefix: "shared:", Role: Read},
+	}}
+	tests := []struct {
+		role Role
+		ns   string
+		key  string
+		want bool
+	}{
+		{role: Read, ns: "default", key: "user:1", want: true},
+		{role: Write, ns: "default", key: "user:1", want: true},
+		{role: Admin, ns: "default", key: "user:1", want: false},
+		{role: Read, ns: "default", key: "item:1", want: true},
+		{role: Write, ns: "default", key: "item:1", want: false},
+		{role: Read, ns: "default", key: "other", want: false},
+		{role: Read, ns: "default", key: "user:", want: true},
+		{role: Read, ns: "default", key: "user", want: false},
+		{role: Read, ns: "default", key: "", want: false},
+		{role: Read, ns: "recommender", key: "user:1", want: false},
+		{role: Admin, ns: "fraud", key: "", want: true},
+		{role: Read, ns: "recommender", key: "shared:1", want: true},
+		{role: Write, ns: "recommender", key: "shared:1", want: false},
+	}
+	for _, tt := range tests {
+		if got := p.Allows(tt.role, tt.ns, tt.key); got != tt.want {
+			t.Errorf("Allows(%v, %q, %q) = %v, want %v", tt.role, tt.ns, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestLoadTokens(t *testing.T) {
+	file := writeFile(t, "tokens.json", []byte(`{"tokens": [
+		{"token": "t1", "name": "reader", "grants": [{"prefix": "", "role": "read"}]},
+		{"token": "t2", "name": "admin", "grants": [{"prefix": "", "role": "ADMIN"}]}
+	]}`))
+	tokens, err := LoadTokens(file)
+	if err != nil {
+		t.Fatalf("LoadTokens() error = %v", err)
+	}
+
+	p, err := tokens.Authenticate(context.Background(), "t2")
+	if err != nil || p.Name != "admin" || !p.Allows(Admin, "default", "a") {
+		t.Errorf("Authenticate(t2) = %v, %v", p, err)
+	}
+	if _, err := tokens.Authenticate(context.Background(), "t3"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Authenticate(t3) error = %v, want %v", err, ErrInvalidToken)
+	}
+
+	invalid := writeFile(t, "invalid.json", []byte(`{"tokens": [{"token": "t", "grants": [{"role": "owner"}]}]}`))
+	if _, err := LoadTokens(invalid); !errors.Is(err, ErrUnknownRole) {
+		t.Errorf("LoadTokens(invalid) error = %v, want %v", err, ErrUnknownRole)
+	}
+}
+
+func segment(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestJWT(t *testing.T) {
+	now := time.Now()
+	grants := []Grant{{Prefix: "ip:", Role: Read}}
+
+	secret := []byte("secret")
+	hs256 := func(claims map[string]any) string {
+		signed := segment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + segment(t, claims)
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signed))
+		return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	}
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	es256 := func(claims map[string]any) string {
+		signed := segment(t, map[string]string{"alg": "ES256", "typ": "JWT"}) + "." + segment(t, claims)
+		digest := sha256.Sum256([]byte(signed))
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature := make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+		return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	valid := map[string]any{"sub": "fraud", "exp": now.Add(time.Hour).Unix(), "grants": grants}
+	tests := []struct {
+		name    string
+		key     []byte
+		token   string
+		wantErr error
+	}{
+		{name: "HS256", key: secret, token: hs256(valid)},
+		{name: "ES256", key: publicKey, token: es256(valid)},
+		{name: "expired", key: secret, token: hs256(map[string]any{"sub": "fraud", "exp": now.Add(-time.Minute).Unix()}), wantErr: ErrInvalidToken},
+		{name: "not valid yet", key: secret, token: hs256(map[string]any{"sub": "fraud", "nbf": now.Add(time.Minute).Unix()}), wantErr: ErrInvalidToken},
+		{name: "wrong algorithm", key: publicKey, token: hs256(valid), wantErr: ErrInvalidToken},
+		{name: "tampered", key: secret, token: hs256(valid) + "x", wantErr: ErrInvalidToken},
+		{name: "not a JWT", key: secret, token: "t1", wantErr: ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j, err := LoadJWTKey(writeFile(t, "key", tt.key))
+			if err != nil {
+				t.Fatalf("LoadJWTKey() error = %v", err)
+			}
+			p, err := j.Authenticate(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (p.Name != "fraud" || !p.Allows(Read, "default", "ip:1") || p.Allows(Read, "default", "user:1")) {
+				t.Errorf("Authenticate() = %+v", p)
+			}
+		})
+	}
+}
+
+func TestInterceptor_Unary(t *testing.T) {
+	tokens := &Tokens{principals: map[[sha256.Size]byte]*Principal{
+		sha256.Sum256([]byte("writer")): {Name: "writer", Grants: []Grant{{Prefix: "user:", Role: Write}}},
+		sha256.Sum256([]byte("admin")):  {Name: "admin", Grants: []Grant{{Prefix: "", Role: Admin}}},
+		sha256.Sum256([]byte("fraud")):  {Name: "fraud", Grants: []Grant{{Namespace: "fraud", Prefix: "", Role: Admin}}},
+	}}
+	interceptor := NewInterceptor(Chain{tokens}).Unary()
+	handler := func(ctx context.Context, req any) (any, error) {
+		return FromContext(ctx).Name, nil
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		namespace     string
+		forwarded     bool
+		req           any
+		want          codes.Code
+	}{
+		{name: "missing token", req: &v1.GetVertexRequest{Key: "user:1"}, want: codes.Unauthenticated},
+		{name: "not bearer", authorization: "Basic writer", req: &v1.GetVertexRequest{Key: "user:1"}, want: codes.Unauthenticated},
+		{name: "unknown token", authorization: "Bearer reader", req: &v1.GetVertexRequest{Key: "user:1"}, want: codes.Unauthenticated},
+		{name: "read", authorization: "Bearer writer", req: &v1.GetVertexRequest{Key: "user:1"}, want: codes.OK},
+		{name: "read other prefix", authorization: "Bearer writer", req: &v1.GetVertexRequest{Key: "item:1"}, want: codes.PermissionDenied},
+		{name: "delete", authorization: "Bearer writer", req: &v1.DeleteVertexRequest{Key: "user:1"}, want: codes.OK},
+		{name: "edge to other prefix", authorization: "Bearer writer", req: &v1.AddEdgeRequest{Edges: []*v1.Edge{{Tail: "user:1", Head: "item:1"}}}, want: codes.PermissionDenied},
+		{name: "scan granted prefix", authorization: "Bearer writer", req: &v1.ScanVerticesRequest{Prefix: "user:1"}, want: codes.OK},
+		{name: "scan all", authorization: "Bearer writer", req: &v1.ScanVerticesRequest{}, want: codes.PermissionDenied},
+		{name: "stats", authorization: "Bearer writer", req: &v1.StatsRequest{}, want: codes.PermissionDenied},
+		{name: "stats by admin", authorization: "Bearer admin", req: &v1.StatsRequest{}, want: codes.OK},
+		{name: "expand", authorization: "Bearer writer", req: &v1.ExpandRequest{Keys: []string{"user:1"}}, want: codes.PermissionDenied},
+		{name: "other namespace", authorization: "Bearer writer", namespace: "fraud", req: &v1.GetVertexRequest{Key: "user:1"}, want: codes.PermissionDenied},
+		{name: "granted namespace", authorization: "Bearer fraud", namespace: "fraud", req: &v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "ip:1"}}}, want: codes.OK},
+		{name: "create granted namespace", authorization: "Bearer fraud", req: &v1.CreateNamespaceRequest{Namespace: &v1.Namespace{Name: "fraud"}}, want: codes.OK},
+		{name: "drop other namespace", authorization: "Bearer fraud", req: &v1.DropNamespaceRequest{Name: "recommender"}, want: codes.PermissionDenied},
+		{name: "list namespaces", authorization: "Bearer fraud", req: &v1.ListNamespacesRequest{}, want: codes.PermissionDenied},
+		{name: "forwarded", authorization: "Bearer writer", forwarded: true, req: &v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "user:1"}}}, want: codes.PermissionDenied},
+		{name: "forwarded by peer", authorization: "Bearer admin", forwarded: true, req: &v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "user:1"}}}, want: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.authorization != "" {
+				md.Set("authorization", tt.authorization)
+			}
+			if tt.namespace != "" {
+				md.Set(namespace.MetadataKey, tt.namespace)
+			}
+			if tt.forwarded {
+				out, _ := metadata.FromOutgoingContext(cluster.Forwarding(context.Background()))
+				md = metadata.Join(md, out)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("code = %v, want %v (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestInterceptor_Unary_filter(t *testing.T) {
+	tokens := &Tokens{principals: map[[sha256.Size]byte]*Principal{
+		sha256.Sum256([]byte("reader")): {Name: "reader", Grants: []Grant{{Prefix: "user:", Role: Read}}},
+	}}
+	interceptor := NewInterceptor(Chain{tokens}).Unary()
+	edges := func() []*v1.Edge {
+		return []*v1.Edge{{Tail: "user:1", Head: "user:2"}, {Tail: "user:1", Head: "item:1"}, {Tail: "item:1", Head: "user:2"}}
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer reader"))
+
+	resp, err := interceptor(ctx, &v1.IlluminateRequest{Seed: "user:1"}, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return &v1.IlluminateResponse{Graph: &v1.Graph{
+			Vertices: []*v1.Vertex{{Key: "user:1"}, {Key: "item:1"}, {Key: "user:2"}},
+			Edges:    edges(),
+		}}, nil
+	})
+	if err != nil {
+		t.Fatalf("Illuminate error = %v", err)
+	}
+	g := resp.(*v1.IlluminateResponse).Graph
+	if len(g.Vertices) != 2 || g.Vertices[0].Key != "user:1" || g.Vertices[1].Key != "user:2" {
+		t.Errorf("vertices = %v, want user:1 and user:2", g.Vertices)
+	}
+	if len(g.Edges) != 1 || g.Edges[0].Head != "user:2" {
+		t.Errorf("edges = %v, want user:1 -> user:2", g.Edges)
+	}
+
+	resp, err = interceptor(ctx, &v1.ListEdgesRequest{Key: "user:1"}, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return &v1.ListEdgesResponse{Edges: edges()}, nil
+	})
+	if err != nil {
+		t.Fatalf("ListOutEdges error = %v", err)
+	}
+	if got := resp.(*v1.ListEdgesResponse).Edges; len(got) != 1 || got[0].Head != "user:2" {
+		t.Errorf("edges = %v, want user:1 -> user:2", got)
+	}
+}
+
+// watchStream receives a WatchRequest and records the messages sent to it.
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	req  *v1.WatchRequest
+	sent []*v1.WatchResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) RecvMsg(m any) error {
+	*m.(*v1.WatchRequest) = v1.WatchRequest{Prefix: s.req.Prefix}
+	return nil
+}
+
+func (s *watchStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m.(*v1.WatchResponse))
+	return nil
+}
+
+func TestInterceptor_Stream_filter(t *testing.T) {
+	tokens := &Tokens{principals: map[[sha256.Size]byte]*Principal{
+		sha256.Sum256([]byte("reader")): {Name: "reader", Grants: []Grant{{Prefix: "user:", Role: Read}}},
+	}}
+	interceptor := NewInterceptor(Chain{tokens}).Stream()
+	events := []*v1.Event{
+		{Type: v1.EventType_EVENT_TYPE_PUT_VERTEX, Vertex: &v1.Vertex{Key: "user:1"}},
+		{Type: v1.EventType_EVENT_TYPE_PUT_VERTEX, Vertex: &v1.Vertex{Key: "item:1"}},
+		{Type: v1.EventType_EVENT_TYPE_ADD_EDGE, Edge: &v1.Edge{Tail: "user:1", Head: "user:2", Weight: 1}},
+		{Type: v1.EventType_EVENT_TYPE_ADD_EDGE, Edge: &v1.Edge{Tail: "user:1", Head: "item:1", Weight: 1}},
+		{Type: v1.EventType_EVENT_TYPE_DELETE_EDGE, Edge: &v1.Edge{Tail: "item:1", Head: "user:2"}},
+	}
+	ss := &watchStream{
+		ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer reader")),
+		req: &v1.WatchRequest{Prefix: "user:"},
+	}
+
+	err := interceptor(nil, ss, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+		var req v1.WatchRequest
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		for _, e := range events {
+			if err := stream.SendMsg(&v1.WatchResponse{Event: e}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Watch error = %v", err)
+	}
+	if len(ss.sent) != 2 || ss.sent[0].Event.GetVertex().GetKey() != "user:1" || ss.sent[1].Event.GetEdge().GetHead() != "user:2" {
+		t.Errorf("sent = %v, want put of user:1 and edge user:1 -> user:2", ss.sent)
+	}
+}
@@ -0,0 +1,240 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/cluster"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// Interceptor authenticates the bearer token in the "authorization" metadata of each RPC,
+// and authorizes the principal on the keys of each request. It rejects RPCs with
+// Unauthenticated or PermissionDenied.
+type Interceptor struct {
+	authenticator Authenticator
+}
+
+func NewInterceptor(authenticator Authenticator) *Interceptor {
+	return &Interceptor{authenticator: authenticator}
+}
+
+// access returns the role required by req and the keys it reads or writes in namespace ns.
+// A prefix of ScanVertices or Watch stands for all keys starting with it. Requests which are
+// not about particular keys, such as Stats or RPCs between nodes, require a role on all keys.
+// Requests to manage a namespace require admin on the namespace.
+func access(ns string, req any) (Role, string, []string) {
+	role, keys := keysOf(req)
+	switch r := req.(type) {
+	case *v1.CreateNamespaceRequest:
+		ns = r.GetNamespace().GetName()
+	case *v1.FlushNamespaceRequest:
+		ns = r.Name
+	case *v1.DropNamespaceRequest:
+		ns = r.Name
+	}
+	return role, ns, keys
+}
+
+func keysOf(req any) (Role, []string) {
+	switch r := req.(type) {
+	case *v1.IlluminateRequest:
+		return Read, []string{r.Seed}
+	case *v1.GetVertexRequest:
+		return Read, []string{r.Key}
+	case *v1.GetVerticesRequest:
+		return Read, r.Keys
+	case *v1.GetEdgeRequest:
+		return Read, []string{r.Tail, r.Head}
+	case *v1.ListEdgesRequest:
+		return Read, []string{r.Key}
+	case *v1.ScanVerticesRequest:
+		return Read, []string{r.Prefix}
+	case *v1.WatchRequest:
+		return Read, []string{r.Prefix}
+	case *v1.PutVertexRequest:
+		return Write, vertexKeys(r.Vertices)
+	case *v1.DeleteVertexRequest:
+		return Write, []string{r.Key}
+	case *v1.AddEdgeRequest:
+		return Write, edgeKeys(r.Edges)
+	case *v1.PutEdgeRequest:
+		return Write, edgeKeys(r.Edges)
+	case *v1.DeleteEdgeRequest:
+		return Write, []string{r.Tail, r.Head}
+	case *v1.TouchRequest:
+		return Write, append(append([]string{}, r.Keys...), edgeKeys(r.Edges)...)
+	case *v1.IngestRequest:
+		return Write, append(vertexKeys(r.Vertices), edgeKeys(r.Edges)...)
+	default:
+		return Admin, []string{""}
+	}
+}
+
+func vertexKeys(vertices []*v1.Vertex) []string {
+	keys := make([]string, 0, len(vertices))
+	for _, v := range vertices {
+		keys = append(keys, v.GetKey())
+	}
+	return keys
+}
+
+func edgeKeys(edges []*v1.Edge) []string {
+	keys := make([]string, 0, 2*len(edges))
+	for _, e := range edges {
+		keys = append(keys, e.GetTail(), e.GetHead())
+	}
+	return keys
+}
+
+func authorize(ctx context.Context, p *Principal, req any) error {
+	role, ns, keys := access(namespace.FromIncomingContext(ctx), req)
+	if cluster.Forwarded(ctx) {
+		// A forwarded request is served without routing it to the owners of its keys, so it
+		// is trusted only from other nodes, like RPCs between nodes.
+		role, keys = Admin, []string{""}
+	}
+	for _, key := range keys {
+		if !p.Allows(role, ns, key) {
+			return status.Errorf(codes.PermissionDenied, "%s is not granted %s on %q in namespace %s", p.Name, role, key, ns)
+		}
+	}
+	return nil
+}
+
+// filter removes the vertices and edges which p is not granted read on from resp, since
+// Illuminate and ListEdges return neighbors of their keys which may be under other prefixes.
+// An edge is removed unless both of its ends are granted.
+func filter(ctx context.Context, p *Principal, resp any) {
+	ns := namespace.FromIncomingContext(ctx)
+	allows := func(e *v1.Edge) bool {
+		return allowsEdge(p, ns, e)
+	}
+	switch r := resp.(type) {
+	case *v1.IlluminateResponse:
+		if r.Graph == nil {
+			return
+		}
+		vertices := r.Graph.Vertices[:0]
+		for _, v := range r.Graph.Vertices {
+			if p.Allows(Read, ns, v.GetKey()) {
+				vertices = append(vertices, v)
+			}
+		}
+		r.Graph.Vertices = vertices
+		r.Graph.Edges = filterEdges(r.Graph.Edges, allows)
+	case *v1.ListEdgesResponse:
+		r.Edges = filterEdges(r.Edges, allows)
+	}
+}
+
+// sendable reports whether p is granted read on the message m sent on a stream. An event of
+// Watch matches a prefix by either end of its edge, so it is sent only if both ends are granted.
+func sendable(ctx context.Context, p *Principal, m any) bool {
+	r, ok := m.(*v1.WatchResponse)
+	if !ok {
+		return true
+	}
+	ns := namespace.FromIncomingContext(ctx)
+	if v := r.GetEvent().GetVertex(); v != nil && !p.Allows(Read, ns, v.GetKey()) {
+		return false
+	}
+	if e := r.GetEvent().GetEdge(); e != nil && !allowsEdge(p, ns, e) {
+		return false
+	}
+	return true
+}
+
+func allowsEdge(p *Principal, ns string, e *v1.Edge) bool {
+	return p.Allows(Read, ns, e.GetTail()) && p.Allows(Read, ns, e.GetHead())
+}
+
+func filterEdges(edges []*v1.Edge, allows func(e *v1.Edge) bool) []*v1.Edge {
+	filtered := edges[:0]
+	for _, e := range edges {
+		if allows(e) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+func (i *Interceptor) authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization is not a bearer token")
+	}
+
+	p, err := i.authenticator.Authenticate(ctx, strings.TrimSpace(token))
+	if errors.Is(err, ErrInvalidToken) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return p, nil
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		p, err := i.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorize(ctx, p, req); err != nil {
+			return nil, err
+		}
+		resp, err := handler(NewContext(ctx, p), req)
+		if err != nil {
+			return nil, err
+		}
+		filter(ctx, p, resp)
+		return resp, nil
+	}
+}
+
+// serverStream authorizes each message received from a stream, and drops each message sent
+// to it which the principal is not granted read on.
+type serverStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	principal *Principal
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorize(s.ctx, s.principal, m)
+}
+
+func (s *serverStream) SendMsg(m any) error {
+	if !sendable(s.ctx, s.principal, m) {
+		return nil
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		p, err := i.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: NewContext(ss.Context(), p), principal: p})
+	}
+}
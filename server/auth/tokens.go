@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Tokens authenticates static bearer tokens loaded from a file.
+type Tokens struct {
+	// principals is keyed by SHA-256 of tokens, so that lookups do not leak tokens by timing.
+	principals map[[sha256.Size]byte]*Principal
+}
+
+type tokensFile struct {
+	Tokens []struct {
+		Token string `json:"token"`
+		Principal
+	} `json:"tokens"`
+}
+
+// LoadTokens reads a JSON file of tokens and their grants, such as:
+//
+//	{"tokens": [{"token": "s3cr3t", "name": "recommender", "grants": [{"prefix": "user:", "role": "write"}]}]}
+func LoadTokens(file string) (*Tokens, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var f tokensFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	t := &Tokens{principals: make(map[[sha256.Size]byte]*Principal)}
+	for i, token := range f.Tokens {
+		if token.Token == "" {
+			return nil, fmt.Errorf("%s: token %d is empty", file, i)
+		}
+		p := token.Principal
+		t.principals[sha256.Sum256([]byte(token.Token))] = &p
+	}
+	return t, nil
+}
+
+func (t *Tokens) Authenticate(_ context.Context, token string) (*Principal, error) {
+	if p, ok := t.principals[sha256.Sum256([]byte(token))]; ok {
+		return p, nil
+	}
+	return nil, ErrInvalidToken
+}
@@ -0,0 +1,191 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// issuer signs certificates for tests.
+type issuer struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newIssuer(t *testing.T) *issuer {
+	t.Helper()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &issuer{cert: cert, key: key}
+}
+
+// write writes the certificate of the issuer to dir, and returns its path.
+func (i *issuer) write(t *testing.T, dir string) string {
+	t.Helper()
+	path := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: i.cert.Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// issue writes a certificate of name and its key to dir, and returns their paths.
+func (i *issuer) issue(t *testing.T, dir string, name string, serial int64) (string, string) {
+	t.Helper()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, i.cert, &key.PublicKey, i.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+
+	certFile := filepath.Join(dir, name+".pem")
+	keyFile := filepath.Join(dir, name+".key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+// handshake connects a client to a server, and returns the serial number of the server certificate.
+func handshake(server, client *tls.Config) (int64, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.(*tls.Conn).Handshake()
+		_, _ = conn.Read(make([]byte, 1))
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), client)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	// The server verifies the client certificate after the client finishes its handshake.
+	if _, err := conn.Write([]byte{0}); err != nil {
+		return 0, err
+	}
+	if _, err := conn.Read(make([]byte, 1)); err != nil && !errors.Is(err, io.EOF) {
+		return 0, err
+	}
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func TestReloader_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newIssuer(t)
+	caFile := ca.write(t, dir)
+	certFile, keyFile := ca.issue(t, dir, "server", 2)
+
+	r, err := NewReloader(certFile, keyFile, caFile, time.Minute)
+	if err != nil {
+		t.Fatalf("NewReloader() error = %v", err)
+	}
+	pool, err := LoadCertPool(caFile)
+	if err != nil {
+		t.Fatalf("LoadCertPool() error = %v", err)
+	}
+
+	clientCert, clientKey := ca.issue(t, dir, "client", 3)
+	client, err := NewReloader(clientCert, clientKey, "", time.Minute)
+	if err != nil {
+		t.Fatalf("NewReloader() error = %v", err)
+	}
+
+	config := client.ClientConfig(pool)
+	config.ServerName = "server"
+	if _, err := handshake(r.ServerConfig(), config); err != nil {
+		t.Errorf("handshake with client certificate error = %v", err)
+	}
+
+	anonymous := &tls.Config{RootCAs: pool, ServerName: "server"}
+	if _, err := handshake(r.ServerConfig(), anonymous); err == nil {
+		t.Errorf("handshake without client certificate succeeds")
+	}
+}
+
+func TestReloader_Reload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newIssuer(t)
+	pool, _ := LoadCertPool(ca.write(t, dir))
+	certFile, keyFile := ca.issue(t, dir, "server", 2)
+
+	r, err := NewReloader(certFile, keyFile, "", time.Minute)
+	if err != nil {
+		t.Fatalf("NewReloader() error = %v", err)
+	}
+	client := &tls.Config{RootCAs: pool, ServerName: "server"}
+
+	// Rotate the certificate, and touch the files in case the clock is coarse.
+	ca.issue(t, dir, "server", 4)
+	later := time.Now().Add(time.Minute)
+	for _, file := range []string{certFile, keyFile} {
+		if err := os.Chtimes(file, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if serial, err := handshake(r.ServerConfig(), client); err != nil || serial != 2 {
+		t.Errorf("handshake before Reload() = %d, %v, want 2", serial, err)
+	}
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if serial, err := handshake(r.ServerConfig(), client); err != nil || serial != 4 {
+		t.Errorf("handshake after Reload() = %d, %v, want 4", serial, err)
+	}
+
+	// An invalid certificate is not loaded.
+	if err := os.WriteFile(certFile, []byte("broken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Reload(); err == nil {
+		t.Errorf("Reload() of broken certificate succeeds")
+	}
+	if serial, err := handshake(r.ServerConfig(), client); err != nil || serial != 4 {
+		t.Errorf("handshake after failed Reload() = %d, %v, want 4", serial, err)
+	}
+}
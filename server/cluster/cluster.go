@@ -0,0 +1,777 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"github.com/anaregdesign/papaya/collection/pq"
+	model "github.com/anaregdesign/papaya/graph"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"sync"
+)
+
+// forwardedKey is a metadata key of requests forwarded from another node.
+// A forwarded request is always served locally, even if the rings of the nodes disagree.
+const forwardedKey = "lantern-forwarded"
+
+var ErrUnknownNode = errors.New("unknown node")
+
+type node struct {
+	conn    *grpc.ClientConn
+	lantern LanternServiceClient
+	cluster ClusterServiceClient
+}
+
+// Caches returns the graph of a namespace stored in this node.
+type Caches interface {
+	Cache(name string) (*graph.GraphCache[string, *Vertex], bool)
+}
+
+// Cluster partitions vertices of each namespace across nodes by consistent hashing of their keys.
+// Edges live with their tail vertex. Every namespace exists in all nodes.
+type Cluster struct {
+	UnimplementedClusterServiceServer
+	self   string
+	ring   *Ring
+	nodes  map[string]*node
+	caches Caches
+}
+
+// NewCluster returns a cluster of nodes, which are addresses to dial. self is the address of this node.
+// Other nodes are dialed with options, or without transport security if there are no options.
+func NewCluster(self string, nodes []string, caches Caches, options ...grpc.DialOption) (*Cluster, error) {
+	member := false
+	for _, addr := range nodes {
+		member = member || addr == self
+	}
+	if !member {
+		return nil, fmt.Errorf("%w: %s is not a node of the cluster", ErrUnknownNode, self)
+	}
+
+	if len(options) == 0 {
+		options = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	options = append(options,
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	)
+
+	c := &Cluster{
+		self:   self,
+		ring:   NewRing(nodes),
+		nodes:  make(map[string]*node),
+		caches: caches,
+	}
+
+	for _, addr := range nodes {
+		if addr == self {
+			continue
+		}
+		conn, err := grpc.Dial(addr, options...)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		c.nodes[addr] = &node{
+			conn:    conn,
+			lantern: NewLanternServiceClient(conn),
+			cluster: NewClusterServiceClient(conn),
+		}
+	}
+	return c, nil
+}
+
+func (c *Cluster) Close() error {
+	var err error
+	for _, n := range c.nodes {
+		err = errors.Join(err, n.conn.Close())
+	}
+	return err
+}
+
+// Local reports whether key is owned by this node, or the request has been forwarded to this node.
+func (c *Cluster) Local(ctx context.Context, key string) bool {
+	return Forwarded(ctx) || c.ring.Owner(key) == c.self
+}
+
+// Owns reports whether key is owned by this node.
+func (c *Cluster) Owns(key string) bool {
+	return c.ring.Owner(key) == c.self
+}
+
+// Node returns a client of the owner of key.
+func (c *Cluster) Node(key string) LanternServiceClient {
+	return c.nodes[c.ring.Owner(key)].lantern
+}
+
+// GetVertices fetches vertices of keys owned by other nodes from their owners, and returns
+// keys owned by this node and vertices found in the other nodes.
+func (c *Cluster) GetVertices(ctx context.Context, keys []string) ([]string, []*Vertex, error) {
+	if Forwarded(ctx) {
+		return keys, nil, nil
+	}
+
+	parts := make(map[string][]string)
+	for _, key := range keys {
+		owner := c.ring.Owner(key)
+		parts[owner] = append(parts[owner], key)
+	}
+	local := parts[c.self]
+	delete(parts, c.self)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var vertices []*Vertex
+	var errs []error
+	ctx = Forwarding(ctx)
+	for owner, part := range parts {
+		wg.Add(1)
+		go func(n *node, part []string) {
+			defer wg.Done()
+			response, err := n.lantern.GetVertices(ctx, &GetVerticesRequest{Keys: part})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			vertices = append(vertices, response.Vertices...)
+		}(c.nodes[owner], part)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, nil, joinErrors(errs)
+	}
+	return local, vertices, nil
+}
+
+// ListInEdges returns a page of incoming edges of request.Key from each of the other nodes,
+// since edges live with their tails which are spread over the cluster.
+func (c *Cluster) ListInEdges(ctx context.Context, request *ListEdgesRequest) ([]*Edge, error) {
+	if Forwarded(ctx) {
+		return nil, nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var edges []*Edge
+	var errs []error
+	ctx = Forwarding(ctx)
+	for _, n := range c.nodes {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+			response, err := n.lantern.ListInEdges(ctx, request)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			edges = append(edges, response.Edges...)
+		}(n)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+	return edges, nil
+}
+
+// ScanVertices asks all other nodes for a page of vertices they own, and returns them together
+// with whether any node has more vertices than the page.
+func (c *Cluster) ScanVertices(ctx context.Context, request *ScanVerticesRequest) ([]*Vertex, bool, error) {
+	if Forwarded(ctx) {
+		return nil, false, nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var vertices []*Vertex
+	var more bool
+	var errs []error
+	ctx = Forwarding(ctx)
+	for _, n := range c.nodes {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+			response, err := n.lantern.ScanVertices(ctx, request)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			vertices = append(vertices, response.Vertices...)
+			more = more || response.NextPageToken != ""
+		}(n)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, false, joinErrors(errs)
+	}
+	return vertices, more, nil
+}
+
+// Stats asks all other nodes for statistics of their own vertices and edges.
+func (c *Cluster) Stats(ctx context.Context, request *StatsRequest) ([]*StatsResponse, error) {
+	if Forwarded(ctx) {
+		return nil, nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var stats []*StatsResponse
+	var errs []error
+	ctx = Forwarding(ctx)
+	for _, n := range c.nodes {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+			response, err := n.lantern.Stats(ctx, request)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			stats = append(stats, response)
+		}(n)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+	return stats, nil
+}
+
+// Forward sends parts of a mutation owned by other nodes to their owners, and returns the
+// part owned by this node, or nil if there is none. The part is returned even if some of the
+// others fail, together with an error naming the keys of the parts which are not written,
+// so that only those are sent again.
+func (c *Cluster) Forward(ctx context.Context, m proto.Message) (proto.Message, error) {
+	if Forwarded(ctx) {
+		return m, nil
+	}
+
+	parts := make(map[string]proto.Message)
+	switch r := m.(type) {
+	case *PutVertexRequest:
+		for _, v := range r.Vertices {
+			owner := c.ring.Owner(v.Key)
+			if _, ok := parts[owner]; !ok {
+				parts[owner] = &PutVertexRequest{}
+			}
+			p := parts[owner].(*PutVertexRequest)
+			p.Vertices = append(p.Vertices, v)
+		}
+
+	case *AddEdgeRequest:
+		for _, e := range r.Edges {
+			owner := c.ring.Owner(e.Tail)
+			if _, ok := parts[owner]; !ok {
+				parts[owner] = &AddEdgeRequest{}
+			}
+			p := parts[owner].(*AddEdgeRequest)
+			p.Edges = append(p.Edges, e)
+		}
+
+	case *PutEdgeRequest:
+		for _, e := range r.Edges {
+			owner := c.ring.Owner(e.Tail)
+			if _, ok := parts[owner]; !ok {
+				parts[owner] = &PutEdgeRequest{}
+			}
+			p := parts[owner].(*PutEdgeRequest)
+			p.Edges = append(p.Edges, e)
+		}
+
+	case *DeleteVertexRequest:
+		// Edges to the vertex live with their tails, so every node deletes the ones it stores.
+		parts[c.self] = r
+		for addr := range c.nodes {
+			parts[addr] = r
+		}
+
+	case *DeleteEdgeRequest:
+		parts[c.ring.Owner(r.Tail)] = r
+
+	case *IngestRequest:
+		ingest := func(owner string) *IngestRequest {
+			if _, ok := parts[owner]; !ok {
+				parts[owner] = &IngestRequest{Id: r.Id}
+			}
+			return parts[owner].(*IngestRequest)
+		}
+		for _, v := range r.Vertices {
+			p := ingest(c.ring.Owner(v.Key))
+			p.Vertices = append(p.Vertices, v)
+		}
+		for _, e := range r.Edges {
+			p := ingest(c.ring.Owner(e.Tail))
+			p.Edges = append(p.Edges, e)
+		}
+
+	case *TouchRequest:
+		touch := func(owner string) *TouchRequest {
+			if _, ok := parts[owner]; !ok {
+				parts[owner] = &TouchRequest{Expiration: r.Expiration}
+			}
+			return parts[owner].(*TouchRequest)
+		}
+		for _, key := range r.Keys {
+			p := touch(c.ring.Owner(key))
+			p.Keys = append(p.Keys, key)
+		}
+		for _, e := range r.Edges {
+			p := touch(c.ring.Owner(e.Tail))
+			p.Edges = append(p.Edges, e)
+		}
+
+	default:
+		return m, nil
+	}
+
+	local := parts[c.self]
+	delete(parts, c.self)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	ctx = Forwarding(ctx)
+	for owner, part := range parts {
+		wg.Add(1)
+		go func(owner string, part proto.Message) {
+			defer wg.Done()
+			if err := c.nodes[owner].forward(ctx, part); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s are not written to node %s: %w", strings.Join(writtenKeys(part), ", "), owner, err))
+				mu.Unlock()
+			}
+		}(owner, part)
+	}
+	wg.Wait()
+
+	return local, joinErrors(errs)
+}
+
+// Broadcast sends a request to create, flush or drop a namespace to all other nodes, which
+// apply it to their own shards. A node which has already created or dropped the namespace
+// leaves it as is, so the request can be sent again to the nodes it has failed on.
+func (c *Cluster) Broadcast(ctx context.Context, m proto.Message) error {
+	if Forwarded(ctx) {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	ctx = Forwarding(ctx)
+	for addr, n := range c.nodes {
+		wg.Add(1)
+		go func(addr string, n *node) {
+			defer wg.Done()
+			var err error
+			switch r := m.(type) {
+			case *CreateNamespaceRequest:
+				_, err = n.lantern.CreateNamespace(ctx, r)
+				if status.Code(err) == codes.AlreadyExists {
+					err = nil
+				}
+			case *FlushNamespaceRequest:
+				_, err = n.lantern.FlushNamespace(ctx, r)
+			case *DropNamespaceRequest:
+				_, err = n.lantern.DropNamespace(ctx, r)
+				if status.Code(err) == codes.NotFound {
+					err = nil
+				}
+			}
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("node %s: %w", addr, err))
+				mu.Unlock()
+			}
+		}(addr, n)
+	}
+	wg.Wait()
+
+	return joinErrors(errs)
+}
+
+// writtenKeys returns the keys of vertices and the tails and heads of edges which m writes.
+func writtenKeys(m proto.Message) []string {
+	var keys []string
+	vertices := func(vertices []*Vertex) {
+		for _, v := range vertices {
+			keys = append(keys, v.Key)
+		}
+	}
+	edges := func(edges []*Edge) {
+		for _, e := range edges {
+			keys = append(keys, e.Tail+" -> "+e.Head)
+		}
+	}
+	switch r := m.(type) {
+	case *PutVertexRequest:
+		vertices(r.Vertices)
+	case *DeleteVertexRequest:
+		keys = append(keys, r.Key)
+	case *AddEdgeRequest:
+		edges(r.Edges)
+	case *PutEdgeRequest:
+		edges(r.Edges)
+	case *DeleteEdgeRequest:
+		keys = append(keys, r.Tail+" -> "+r.Head)
+	case *TouchRequest:
+		keys = append(keys, r.Keys...)
+		edges(r.Edges)
+	case *IngestRequest:
+		vertices(r.Vertices)
+		edges(r.Edges)
+	}
+	return keys
+}
+
+// joinErrors returns a single error as is to keep its status code, and joins several errors.
+// Fan-out reads return it without results if any node fails, rather than a part of the graph
+// which looks complete.
+func joinErrors(errs []error) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+func (n *node) forward(ctx context.Context, m proto.Message) error {
+	var err error
+	switch r := m.(type) {
+	case *PutVertexRequest:
+		_, err = n.lantern.PutVertex(ctx, r)
+	case *DeleteVertexRequest:
+		_, err = n.lantern.DeleteVertex(ctx, r)
+	case *AddEdgeRequest:
+		_, err = n.lantern.AddEdge(ctx, r)
+	case *PutEdgeRequest:
+		_, err = n.lantern.PutEdge(ctx, r)
+	case *DeleteEdgeRequest:
+		_, err = n.lantern.DeleteEdge(ctx, r)
+	case *TouchRequest:
+		_, err = n.lantern.Touch(ctx, r)
+	case *IngestRequest:
+		err = n.ingest(ctx, r)
+	}
+	return err
+}
+
+// ingest writes a batch to the node over a stream of its own, and returns the error of a
+// batch which is not acknowledged as OK.
+func (n *node) ingest(ctx context.Context, r *IngestRequest) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := n.lantern.Ingest(ctx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(r); err != nil {
+		return err
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	return ingestError(response)
+}
+
+// ingestError returns the error of a batch acknowledged with a status other than OK.
+func ingestError(response *IngestResponse) error {
+	switch response.Status {
+	case Status_STATUS_OK:
+		return nil
+	case Status_STATUS_INVALID_REQUEST:
+		return status.Error(codes.InvalidArgument, response.Message)
+	case Status_STATUS_RESOURCE_EXHAUSTED:
+		return status.Error(codes.ResourceExhausted, response.Message)
+	default:
+		return status.Error(codes.Internal, response.Message)
+	}
+}
+
+// Neighbor explores the graph from seed like GraphCache.NeighborWithDirection, but fans out
+// each step to the nodes which store edges of the frontier, and merges their results.
+// Outbound edges are asked to the owners of the frontier, and inbound edges to all nodes.
+//
+// TF-IDF is computed from the edges stored in each node, so it can differ from the one of a single node.
+func (c *Cluster) Neighbor(ctx context.Context, seed string, step int, k int, tfidf bool, direction graph.Direction) (*model.Graph[string, *Vertex], error) {
+	g := model.NewGraph[string, *Vertex]()
+
+	s, err := c.expand(ctx, []string{seed}, 0, tfidf, graph.Outbound)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.vertices[seed]; !ok {
+		return g, nil
+	}
+	g.Vertices[seed] = s.vertices[seed]
+
+	seen := make(map[string]struct{})
+	targets := []string{seed}
+	for i := 0; i < step && len(targets) > 0; i++ {
+		sub, err := c.expand(ctx, targets, k, tfidf, direction)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range targets {
+			seen[t] = struct{}{}
+		}
+
+		next := make(map[string]struct{})
+		for key, edges := range sub.top(k) {
+			for _, e := range edges {
+				if _, ok := g.Edges[e.Tail]; !ok {
+					g.Edges[e.Tail] = make(map[string]float32)
+				}
+				g.Edges[e.Tail][e.Head] = e.Weight
+
+				other := e.Head
+				if other == key {
+					other = e.Tail
+				}
+				if _, ok := seen[other]; !ok {
+					next[other] = struct{}{}
+				}
+			}
+		}
+		targets = targets[:0]
+		for key := range next {
+			targets = append(targets, key)
+		}
+	}
+
+	// Add vertices to the graph
+	var keys []string
+	for tail, heads := range g.Edges {
+		keys = append(keys, tail)
+		for head := range heads {
+			keys = append(keys, head)
+		}
+	}
+	vertices, err := c.expand(ctx, keys, 0, tfidf, graph.Outbound)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		g.Vertices[key] = vertices.vertices[key]
+	}
+	return g, nil
+}
+
+// expansion is a merged result of Expand of nodes.
+type expansion struct {
+	keys     map[string]struct{}
+	vertices map[string]*Vertex
+	edges    map[string]map[[2]string]float32
+}
+
+func (x *expansion) merge(sub *Graph) {
+	for _, v := range sub.Vertices {
+		x.vertices[v.Key] = v
+	}
+	for _, e := range sub.Edges {
+		for _, key := range []string{e.Tail, e.Head} {
+			if _, ok := x.keys[key]; !ok {
+				continue
+			}
+			if _, ok := x.edges[key]; !ok {
+				x.edges[key] = make(map[[2]string]float32)
+			}
+			x.edges[key][[2]string{e.Tail, e.Head}] = e.Weight
+		}
+	}
+}
+
+// top returns top k edges of each key among the edges merged.
+func (x *expansion) top(k int) map[string][]*Edge {
+	top := make(map[string][]*Edge, len(x.edges))
+	for key, edges := range x.edges {
+		for p, weight := range pq.SortableMap[[2]string, float32](edges).Top(k) {
+			top[key] = append(top[key], &Edge{
+				Tail:   p[0],
+				Head:   p[1],
+				Weight: weight,
+			})
+		}
+	}
+	return top
+}
+
+// expand asks the owners of keys for their vertices and outbound edges, and all nodes for
+// inbound edges of keys.
+func (c *Cluster) expand(ctx context.Context, keys []string, k int, tfidf bool, direction graph.Direction) (*expansion, error) {
+	x := &expansion{
+		keys:     make(map[string]struct{}, len(keys)),
+		vertices: make(map[string]*Vertex),
+		edges:    make(map[string]map[[2]string]float32),
+	}
+	for _, key := range keys {
+		x.keys[key] = struct{}{}
+	}
+
+	type call struct {
+		owner     string
+		keys      []string
+		direction graph.Direction
+	}
+	var calls []call
+	if direction == graph.Outbound || direction == graph.Both || k == 0 {
+		parts := make(map[string][]string)
+		for _, key := range keys {
+			owner := c.ring.Owner(key)
+			parts[owner] = append(parts[owner], key)
+		}
+		for owner, part := range parts {
+			calls = append(calls, call{owner: owner, keys: part, direction: graph.Outbound})
+		}
+	}
+	if (direction == graph.Inbound || direction == graph.Both) && k > 0 {
+		calls = append(calls, call{owner: c.self, keys: keys, direction: graph.Inbound})
+		for owner := range c.nodes {
+			calls = append(calls, call{owner: owner, keys: keys, direction: graph.Inbound})
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	merge := func(sub *Graph) {
+		mu.Lock()
+		defer mu.Unlock()
+		x.merge(sub)
+	}
+
+	cache, err := c.cache(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = Forwarding(ctx)
+	for _, call := range calls {
+		if call.owner == c.self {
+			merge(neighbors(cache, call.keys, k, tfidf, call.direction))
+			continue
+		}
+
+		wg.Add(1)
+		go func(n *node, keys []string, direction graph.Direction) {
+			defer wg.Done()
+			response, e := n.cluster.Expand(ctx, &ExpandRequest{
+				Keys:      keys,
+				K:         uint32(k),
+				Tfidf:     tfidf,
+				Direction: directions[direction],
+			})
+			if e != nil {
+				mu.Lock()
+				errs = append(errs, e)
+				mu.Unlock()
+				return
+			}
+			merge(response.Graph)
+		}(c.nodes[call.owner], call.keys, call.direction)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+	return x, nil
+}
+
+var directions = map[graph.Direction]Direction{
+	graph.Outbound: Direction_DIRECTION_OUTBOUND,
+	graph.Inbound:  Direction_DIRECTION_INBOUND,
+	graph.Both:     Direction_DIRECTION_BOTH,
+}
+
+// Expand returns vertices of request.Keys and their top edges in the namespace of the request.
+func (c *Cluster) Expand(ctx context.Context, request *ExpandRequest) (*ExpandResponse, error) {
+	cache, err := c.cache(ctx)
+	if err != nil {
+		return nil, err
+	}
+	direction := graph.Outbound
+	if request.Direction == Direction_DIRECTION_INBOUND {
+		direction = graph.Inbound
+	}
+	return &ExpandResponse{
+		Graph: neighbors(cache, request.Keys, int(request.K), request.Tfidf, direction),
+	}, nil
+}
+
+// cache returns the graph of the namespace of a request stored in this node.
+func (c *Cluster) cache(ctx context.Context) (*graph.GraphCache[string, *Vertex], error) {
+	name := namespace.FromIncomingContext(ctx)
+	cache, ok := c.caches.Cache(name)
+	if !ok {
+		return nil, namespace.NotFound("namespace not found: " + name)
+	}
+	return cache, nil
+}
+
+// neighbors returns keys which exist in the cache, and top k edges from or to each of them.
+func neighbors(cache *graph.GraphCache[string, *Vertex], keys []string, k int, tfidf bool, direction graph.Direction) *Graph {
+	g := &Graph{}
+	for _, key := range keys {
+		if v, ok := cache.GetVertex(key); ok {
+			if v == nil {
+				v = &Vertex{
+					Key: key,
+					Value: &Vertex_Nil{
+						Nil: true,
+					},
+				}
+			}
+			g.Vertices = append(g.Vertices, v)
+		}
+
+		if k <= 0 {
+			continue
+		}
+		for _, e := range cache.Adjacent(key, k, tfidf, direction) {
+			g.Edges = append(g.Edges, &Edge{
+				Tail:   e.Tail,
+				Head:   e.Head,
+				Weight: e.Weight,
+			})
+		}
+	}
+	return g
+}
+
+// Forwarding returns a context of a request to forward to another node, which serves it locally
+// in the namespace of the incoming request.
+func Forwarding(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, forwardedKey, "true", namespace.MetadataKey, namespace.FromIncomingContext(ctx))
+}
+
+// Forwarded reports whether a request has been forwarded from another node. Only peers may
+// forward requests, which the auth interceptor checks when authentication is enabled.
+func Forwarded(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(forwardedKey)) > 0
+}
@@ -0,0 +1,354 @@
+package cluster
+
+import (
+	"context"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// nodeServer serves mutations forwarded to a node by adding them to its cache.
+type nodeServer struct {
+	UnimplementedLanternServiceServer
+	cache *graph.GraphCache[string, *Vertex]
+}
+
+func (s *nodeServer) PutVertex(ctx context.Context, r *PutVertexRequest) (*PutVertexResponse, error) {
+	if !Forwarded(ctx) {
+		return nil, status.Error(codes.FailedPrecondition, "not forwarded")
+	}
+	for _, v := range r.Vertices {
+		s.cache.AddVertexWithTTL(v.Key, v, time.Hour)
+	}
+	return &PutVertexResponse{Status: Status_STATUS_OK}, nil
+}
+
+func (s *nodeServer) AddEdge(ctx context.Context, r *AddEdgeRequest) (*AddEdgeResponse, error) {
+	if !Forwarded(ctx) {
+		return nil, status.Error(codes.FailedPrecondition, "not forwarded")
+	}
+	for _, e := range r.Edges {
+		s.cache.AddEdge(e.Tail, e.Head, e.Weight)
+	}
+	return &AddEdgeResponse{Status: Status_STATUS_OK}, nil
+}
+
+// caches stores graphs of namespaces by their names.
+type caches map[string]*graph.GraphCache[string, *Vertex]
+
+func (c caches) Cache(name string) (*graph.GraphCache[string, *Vertex], bool) {
+	cache, ok := c[name]
+	return cache, ok
+}
+
+// startCluster serves n nodes of a cluster over loopback connections, and returns them.
+func startCluster(t *testing.T, n int) []*Cluster {
+	t.Helper()
+	listeners := make([]net.Listener, n)
+	addrs := make([]string, n)
+	for i := range listeners {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("net.Listen() error = %v", err)
+		}
+		listeners[i] = listener
+		addrs[i] = listener.Addr().String()
+	}
+
+	clusters := make([]*Cluster, n)
+	for i, listener := range listeners {
+		cache := graph.NewGraphCache[string, *Vertex](time.Hour)
+		c, err := NewCluster(addrs[i], addrs, caches{namespace.Default: cache})
+		if err != nil {
+			t.Fatalf("NewCluster() error = %v", err)
+		}
+		t.Cleanup(func() { c.Close() })
+		server := grpc.NewServer()
+		RegisterClusterServiceServer(server, c)
+		RegisterLanternServiceServer(server, &nodeServer{cache: cache})
+		go server.Serve(listener)
+		t.Cleanup(server.Stop)
+		clusters[i] = c
+	}
+	return clusters
+}
+
+// cacheOf returns the graph of the default namespace stored in c.
+func cacheOf(c *Cluster) *graph.GraphCache[string, *Vertex] {
+	cache, _ := c.caches.Cache(namespace.Default)
+	return cache
+}
+
+// owner returns the node of clusters which owns key.
+func owner(clusters []*Cluster, key string) *Cluster {
+	for _, c := range clusters {
+		if c.Owns(key) {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestCluster_Forward(t *testing.T) {
+	clusters := startCluster(t, 3)
+	request := &AddEdgeRequest{}
+	for i := 0; i < 30; i++ {
+		request.Edges = append(request.Edges, &Edge{Tail: "k" + strconv.Itoa(i), Head: "x", Weight: 1})
+	}
+
+	local, err := clusters[0].Forward(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Forward() error = %v", err)
+	}
+	if local != nil {
+		for _, e := range local.(*AddEdgeRequest).Edges {
+			if !clusters[0].Owns(e.Tail) {
+				t.Errorf("Forward() returns %s -> %s owned by another node", e.Tail, e.Head)
+			}
+			cacheOf(clusters[0]).AddEdge(e.Tail, e.Head, e.Weight)
+		}
+	}
+	for _, e := range request.Edges {
+		for _, c := range clusters {
+			if _, ok := cacheOf(c).GetWeight(e.Tail, e.Head); ok != c.Owns(e.Tail) {
+				t.Errorf("%s -> %s is stored in %s: %v, want %v", e.Tail, e.Head, c.self, ok, c.Owns(e.Tail))
+			}
+		}
+	}
+
+	// A forwarded request is served locally as a whole.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(forwardedKey, "true"))
+	if local, err := clusters[0].Forward(ctx, request); err != nil || local != request {
+		t.Errorf("Forward() of a forwarded request = %v, %v, want the request itself", local, err)
+	}
+}
+
+// startDegradedCluster returns a node of a cluster of two, whose other node is down.
+func startDegradedCluster(t *testing.T) *Cluster {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	down := listener.Addr().String()
+	listener.Close()
+	cache := graph.NewGraphCache[string, *Vertex](time.Hour)
+	c, err := NewCluster("127.0.0.1:0", []string{"127.0.0.1:0", down}, caches{namespace.Default: cache})
+	if err != nil {
+		t.Fatalf("NewCluster() error = %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// spreadKeys returns keys of which some are owned by c and the others are not.
+func spreadKeys(t *testing.T, c *Cluster) []string {
+	t.Helper()
+	var keys []string
+	owned := 0
+	for i := 0; i < 10000; i++ {
+		key := "k" + strconv.Itoa(i)
+		keys = append(keys, key)
+		if c.Owns(key) {
+			owned++
+		}
+		if owned >= 3 && len(keys)-owned >= 3 {
+			return keys
+		}
+	}
+	t.Fatalf("no keys are spread over the nodes")
+	return nil
+}
+
+func TestCluster_Forward_unavailable(t *testing.T) {
+	c := startDegradedCluster(t)
+
+	request := &AddEdgeRequest{}
+	for _, key := range spreadKeys(t, c) {
+		request.Edges = append(request.Edges, &Edge{Tail: key, Head: "x", Weight: 1})
+	}
+	local, err := c.Forward(context.Background(), request)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("Forward() error = %v, want %v", err, codes.Unavailable)
+	}
+	var owned []string
+	if local != nil {
+		for _, e := range local.(*AddEdgeRequest).Edges {
+			owned = append(owned, e.Tail)
+		}
+	}
+	if len(owned) == 0 || len(owned) == len(request.Edges) {
+		t.Errorf("Forward() returns %d edges of this node, want some of %d", len(owned), len(request.Edges))
+	}
+
+	// The error names the edges of the node which is down, and only them.
+	named := make(map[string]bool)
+	list, _, _ := strings.Cut(err.Error(), " are not written")
+	for _, edge := range strings.Split(list, ", ") {
+		named[edge] = true
+	}
+	for _, e := range request.Edges {
+		if got, want := named[e.Tail+" -> "+e.Head], !c.Owns(e.Tail); got != want {
+			t.Errorf("Forward() error names %s -> %s: %v, want %v", e.Tail, e.Head, got, want)
+		}
+	}
+}
+
+func TestCluster_unavailable(t *testing.T) {
+	c := startDegradedCluster(t)
+	keys := spreadKeys(t, c)
+	for _, key := range keys {
+		if c.Owns(key) {
+			cacheOf(c).AddEdge(key, "x", 1)
+		}
+	}
+
+	// Reads fail as a whole rather than return the part of the graph stored by this node.
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func() (any, error)
+	}{
+		{name: "GetVertices", call: func() (any, error) {
+			local, remote, err := c.GetVertices(ctx, keys)
+			if local == nil && remote == nil {
+				return nil, err
+			}
+			return local, err
+		}},
+		{name: "ListInEdges", call: func() (any, error) {
+			edges, err := c.ListInEdges(ctx, &ListEdgesRequest{Key: "x"})
+			if edges == nil {
+				return nil, err
+			}
+			return edges, err
+		}},
+		{name: "ScanVertices", call: func() (any, error) {
+			vertices, _, err := c.ScanVertices(ctx, &ScanVerticesRequest{})
+			if vertices == nil {
+				return nil, err
+			}
+			return vertices, err
+		}},
+		{name: "Stats", call: func() (any, error) {
+			stats, err := c.Stats(ctx, &StatsRequest{})
+			if stats == nil {
+				return nil, err
+			}
+			return stats, err
+		}},
+		{name: "Neighbor", call: func() (any, error) {
+			g, err := c.Neighbor(ctx, "x", 1, 10, false, graph.Inbound)
+			if g == nil {
+				return nil, err
+			}
+			return g, err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			if status.Code(err) != codes.Unavailable || got != nil {
+				t.Errorf("%s() = %v, %v, want nil, %v", tt.name, got, err, codes.Unavailable)
+			}
+		})
+	}
+}
+
+func TestCluster_Neighbor(t *testing.T) {
+	clusters := startCluster(t, 3)
+	// Every vertex has edges to two others and from two others, so the tree depends on direction.
+	edges := []struct {
+		tail, head string
+		weight     float32
+	}{
+		{"a", "b", 9}, {"a", "c", 2}, {"b", "c", 8}, {"b", "d", 3},
+		{"c", "d", 7}, {"c", "a", 4}, {"d", "a", 6}, {"d", "b", 5},
+		{"e", "a", 10}, {"e", "d", 1},
+	}
+	whole := graph.NewGraphCache[string, *Vertex](time.Hour)
+	for _, e := range edges {
+		whole.AddEdge(e.tail, e.head, e.weight)
+		cacheOf(owner(clusters, e.tail)).AddEdge(e.tail, e.head, e.weight)
+	}
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		v := &Vertex{Key: key, Value: &Vertex_String_{String_: key}}
+		whole.AddVertexWithTTL(key, v, time.Hour)
+		cacheOf(owner(clusters, key)).AddVertexWithTTL(key, v, time.Hour)
+	}
+
+	for _, direction := range []graph.Direction{graph.Outbound, graph.Inbound, graph.Both} {
+		for _, c := range clusters {
+			got, err := c.Neighbor(context.Background(), "a", 2, 1, false, direction)
+			if err != nil {
+				t.Fatalf("Neighbor(%v) error = %v", direction, err)
+			}
+			want := whole.NeighborWithDirection("a", 2, 1, false, direction)
+			if !reflect.DeepEqual(got.Edges, want.Edges) {
+				t.Errorf("Neighbor(%v) from %s edges = %v, want %v", direction, c.self, got.Edges, want.Edges)
+			}
+			if gotKeys, wantKeys := keysOf(got.Vertices), keysOf(want.Vertices); !reflect.DeepEqual(gotKeys, wantKeys) {
+				t.Errorf("Neighbor(%v) from %s vertices = %v, want %v", direction, c.self, gotKeys, wantKeys)
+			}
+			for key, v := range got.Vertices {
+				if v.GetString_() != key {
+					t.Errorf("Neighbor(%v) from %s vertex %s = %v", direction, c.self, key, v)
+				}
+			}
+		}
+	}
+}
+
+func keysOf(vertices map[string]*Vertex) []string {
+	keys := make([]string, 0, len(vertices))
+	for key := range vertices {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestCluster_Expand(t *testing.T) {
+	c := startCluster(t, 1)[0]
+	cacheOf(c).AddEdge("a", "b", 2)
+	cacheOf(c).AddEdge("a", "c", 1)
+	cacheOf(c).AddEdge("d", "a", 3)
+
+	tests := []struct {
+		name      string
+		direction Direction
+		want      []string
+	}{
+		{name: "outbound", direction: Direction_DIRECTION_OUTBOUND, want: []string{"a->b"}},
+		{name: "inbound", direction: Direction_DIRECTION_INBOUND, want: []string{"d->a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := c.Expand(context.Background(), &ExpandRequest{Keys: []string{"a", "missing"}, K: 1, Direction: tt.direction})
+			if err != nil {
+				t.Fatalf("Expand() error = %v", err)
+			}
+			var got []string
+			for _, e := range response.Graph.Edges {
+				got = append(got, e.Tail+"->"+e.Head)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expand() edges = %v, want %v", got, tt.want)
+			}
+			if len(response.Graph.Vertices) != 1 || response.Graph.Vertices[0].Key != "a" {
+				t.Errorf("Expand() vertices = %v, want a", response.Graph.Vertices)
+			}
+		})
+	}
+}
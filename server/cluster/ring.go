@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+)
+
+// replicas is the number of virtual nodes of each node on a Ring.
+const replicas = 128
+
+// Ring assigns keys to nodes by consistent hashing, so that adding or removing a node
+// only moves the keys of that node.
+type Ring struct {
+	hashes []uint64
+	nodes  map[uint64]string
+}
+
+func NewRing(nodes []string) *Ring {
+	r := &Ring{
+		nodes: make(map[uint64]string),
+	}
+	for _, node := range nodes {
+		for i := 0; i < replicas; i++ {
+			h := hash(node + "#" + strconv.Itoa(i))
+			r.hashes = append(r.hashes, h)
+			r.nodes[h] = node
+		}
+	}
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	return r
+}
+
+// Owner returns the node which owns key.
+func (r *Ring) Owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	h := hash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.nodes[r.hashes[i]]
+}
+
+func hash(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
@@ -0,0 +1,49 @@
+package cluster
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestRing_Owner(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes []string
+	}{
+		{name: "no node", nodes: nil},
+		{name: "single node", nodes: []string{"a:6380"}},
+		{name: "three nodes", nodes: []string{"a:6380", "b:6380", "c:6380"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRing(tt.nodes)
+			counts := make(map[string]int)
+			for i := 0; i < 3000; i++ {
+				counts[r.Owner("key"+strconv.Itoa(i))]++
+			}
+
+			if len(tt.nodes) == 0 {
+				if counts[""] != 3000 {
+					t.Errorf("Owner() of an empty ring = %v, want \"\"", counts)
+				}
+				return
+			}
+			for _, node := range tt.nodes {
+				if counts[node] < 3000/len(tt.nodes)/2 {
+					t.Errorf("Owner() assigns %d keys to %s, which is too unbalanced: %v", counts[node], node, counts)
+				}
+			}
+		})
+	}
+}
+
+func TestRing_Owner_AddNode(t *testing.T) {
+	before := NewRing([]string{"a:6380", "b:6380", "c:6380"})
+	after := NewRing([]string{"a:6380", "b:6380", "c:6380", "d:6380"})
+	for i := 0; i < 3000; i++ {
+		key := "key" + strconv.Itoa(i)
+		if o := after.Owner(key); o != "d:6380" && o != before.Owner(key) {
+			t.Errorf("Owner(%s) moved from %s to %s", key, before.Owner(key), o)
+		}
+	}
+}
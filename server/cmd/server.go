@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
-	"log"
+	"github.com/anaregdesign/lantern/server/logging"
+	"github.com/anaregdesign/lantern/server/provider"
+	"golang.org/x/exp/slog"
 	"os"
 	"os/signal"
 	"syscall"
@@ -10,28 +12,35 @@ import (
 
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
-	log.Println("Environment Variables:")
-	for _, pair := range os.Environ() {
-		log.Println(pair)
+	config, err := provider.NewConfig()
+	if err != nil {
+		slog.Error("Invalid configuration", "error", err)
+		os.Exit(1)
 	}
+	logger, err := provider.NewLogger(config)
+	if err != nil {
+		slog.Error("Invalid logging configuration", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("Environment variables", "env", logging.Environ(os.Environ()))
 
-	signalCh := make(chan os.Signal)
-	defer close(signalCh)
-
+	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
 	go func() {
 		<-signalCh
 		cancel()
 	}()
 
-	svr, err := initializeLanternServer()
+	svr, err := initializeLanternServer(logger)
 	if err != nil {
-		log.Fatal(err)
+		logger.Error("Failed to initialize server", "error", err)
+		os.Exit(1)
 	}
 
-	log.Println("Starting Lantern Server")
+	logger.Info("Starting Lantern Server")
 	if err := svr.Run(ctx); err != nil {
-		log.Fatal(err)
+		logger.Error("Server stopped", "error", err)
+		os.Exit(1)
 	}
 
 }
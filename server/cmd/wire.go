@@ -7,12 +7,28 @@ import (
 	"github.com/anaregdesign/lantern/server/provider"
 	"github.com/anaregdesign/lantern/server/service"
 	"github.com/google/wire"
+	"golang.org/x/exp/slog"
 )
 
-func initializeLanternServer() (*service.LanternServer, error) {
+func initializeLanternServer(logger *slog.Logger) (*service.LanternServer, error) {
 	wire.Build(
 		provider.NewConfig,
+		provider.NewLoggingInterceptor,
+		provider.NewAuthInterceptor,
 		provider.NewGraphCache,
+		provider.NewCertReloader,
+		provider.NewDialOptions,
+		provider.NewSweeper,
+		provider.NewNamespaces,
+		provider.NewWAL,
+		provider.NewSnapshotter,
+		provider.NewLeader,
+		provider.NewFollower,
+		provider.NewCluster,
+		provider.NewSink,
+		provider.NewMetrics,
+		provider.NewMetricsServer,
+		provider.NewTracerProvider,
 		provider.NewListener,
 		provider.NewGrpcServerOptions,
 		provider.NewGrpcServer,
@@ -9,20 +9,63 @@ package main
 import (
 	"github.com/anaregdesign/lantern/server/provider"
 	"github.com/anaregdesign/lantern/server/service"
+	"golang.org/x/exp/slog"
 )
 
 // Injectors from wire.go:
 
-func initializeLanternServer() (*service.LanternServer, error) {
-	config := provider.NewConfig()
-	graphCache := provider.NewGraphCache(config)
-	lanternService := service.NewLanternService(graphCache)
-	v := provider.NewGrpcServerOptions()
-	server := provider.NewGrpcServer(v)
-	listener, err := provider.NewListener()
+func initializeLanternServer(logger *slog.Logger) (*service.LanternServer, error) {
+	config, err := provider.NewConfig()
 	if err != nil {
 		return nil, err
 	}
-	lanternServer := service.NewLanternServer(lanternService, server, listener)
+	graphCache, err := provider.NewGraphCache(config)
+	if err != nil {
+		return nil, err
+	}
+	namespaces := provider.NewNamespaces(config, graphCache)
+	wal, err := provider.NewWAL(config, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	reloader, err := provider.NewCertReloader(config)
+	if err != nil {
+		return nil, err
+	}
+	v, err := provider.NewDialOptions(config, reloader)
+	if err != nil {
+		return nil, err
+	}
+	follower := provider.NewFollower(config, namespaces, v)
+	cluster, err := provider.NewCluster(config, namespaces, v)
+	if err != nil {
+		return nil, err
+	}
+	sink, err := provider.NewSink(config)
+	if err != nil {
+		return nil, err
+	}
+	lanternService := service.NewLanternService(namespaces, wal, follower, cluster, sink)
+	leader := provider.NewLeader(wal)
+	sweeper := provider.NewSweeper(config, graphCache)
+	metrics := provider.NewMetrics(graphCache, sweeper)
+	tracerProvider, err := provider.NewTracerProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	interceptor := provider.NewLoggingInterceptor(config, logger)
+	authInterceptor, err := provider.NewAuthInterceptor(config)
+	if err != nil {
+		return nil, err
+	}
+	v2 := provider.NewGrpcServerOptions(metrics, tracerProvider, interceptor, authInterceptor, reloader)
+	server := provider.NewGrpcServer(v2)
+	listener, err := provider.NewListener(config)
+	if err != nil {
+		return nil, err
+	}
+	snapshotter := provider.NewSnapshotter(config, wal)
+	httpServer := provider.NewMetricsServer(config, metrics)
+	lanternServer := service.NewLanternServer(lanternService, leader, server, listener, snapshotter, sweeper, httpServer, tracerProvider, reloader)
 	return lanternServer, nil
 }
@@ -0,0 +1,562 @@
+package graph
+
+import (
+	"context"
+	"github.com/anaregdesign/papaya/collection/pq"
+	"github.com/anaregdesign/papaya/collection/set"
+	model "github.com/anaregdesign/papaya/graph"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// GraphCache is an in-memory graph whose vertices and edges expire as time passes.
+// It is derived from github.com/anaregdesign/papaya/cache/graph, and additionally
+// exposes its whole content so that it can be persisted.
+type GraphCache[S comparable, T any] struct {
+	mu         sync.RWMutex
+	defaultTTL time.Duration
+	vertices   map[S]volatile[T]
+	edges      *edgeCache[S]
+	expiries   *expiryQueue[S]
+	onExpire   []func([]Vertex[S, T], []Edge[S])
+
+	limits      Limits[S, T]
+	vertexBytes int64
+	clock       atomic.Int64
+	onEvict     []func([]Vertex[S, T], []Edge[S])
+	slidingTTL  time.Duration
+}
+
+type volatile[T any] struct {
+	value      T
+	expiration time.Time
+	usage      *usage
+}
+
+// expiresAt returns when the vertex expires, which is extended by its sliding TTL.
+func (v volatile[T]) expiresAt() time.Time {
+	return v.usage.expiresAt(v.expiration)
+}
+
+func (v volatile[T]) expired(now time.Time) bool {
+	return v.expiresAt().Before(now)
+}
+
+// Vertex is a point-in-time copy of a vertex stored in GraphCache. Expiration is extended to
+// SlidingTTL after each read, unless SlidingTTL is 0.
+type Vertex[S comparable, T any] struct {
+	Key        S
+	Value      T
+	Expiration time.Time
+	SlidingTTL time.Duration
+}
+
+// Edge is a point-in-time copy of a weight added to an edge stored in GraphCache.
+// An edge which was added several times is exported as several Edge values.
+type Edge[S comparable] struct {
+	Tail       S
+	Head       S
+	Weight     float32
+	Expiration time.Time
+	SlidingTTL time.Duration
+	// Added is when Weight is added, from which it decays.
+	Added time.Time
+}
+
+func NewGraphCache[S comparable, T any](defaultTTL time.Duration) *GraphCache[S, T] {
+	c := &GraphCache[S, T]{
+		defaultTTL: defaultTTL,
+		vertices:   make(map[S]volatile[T]),
+		expiries:   newExpiryQueue[S](),
+	}
+	c.edges = newEdgeCache[S](c.keySize)
+	return c
+}
+
+// SetHalfLife makes weights of edges decay exponentially, halving every halfLife since they are
+// added, or stop decaying if halfLife is 0. Weights added to an edge are summed up after decay,
+// so adding a weight to an edge adds it to the decayed weight of the edge.
+func (c *GraphCache[S, T]) SetHalfLife(halfLife time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.edges.halfLife = halfLife
+}
+
+// HalfLife returns the half-life set by SetHalfLife.
+func (c *GraphCache[S, T]) HalfLife() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.edges.halfLife
+}
+
+func (c *GraphCache[S, T]) GetVertex(key S) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	v, ok := c.getVertex(key)
+	if ok {
+		c.read(c.vertices[key].usage)
+	}
+	return v, ok
+}
+
+func (c *GraphCache[S, T]) getVertex(key S) (T, bool) {
+	if v, ok := c.vertices[key]; ok && !v.expired(time.Now()) {
+		return v.value, true
+	}
+	var noop T
+	return noop, false
+}
+
+func (c *GraphCache[S, T]) hasVertex(key S) bool {
+	_, ok := c.getVertex(key)
+	return ok
+}
+
+func (c *GraphCache[S, T]) GetWeight(tail, head S) (float32, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	w, ok := c.edges.get(tail, head)
+	if ok {
+		c.read(&c.edges.tf[tail][head].usage)
+	}
+	return w, ok
+}
+
+// OutEdges returns weights of edges from tail indexed by their heads.
+func (c *GraphCache[S, T]) OutEdges(tail S) map[S]float32 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.hasVertex(tail) {
+		c.read(c.vertices[tail].usage)
+	}
+	edges := make(map[S]float32, len(c.edges.tf[tail]))
+	for head, weight := range c.edges.tf[tail] {
+		if w, ok := c.edges.get(tail, head); ok {
+			edges[head] = w
+			c.read(&weight.usage)
+		}
+	}
+	return edges
+}
+
+// InEdges returns weights of edges to head indexed by their tails.
+func (c *GraphCache[S, T]) InEdges(head S) map[S]float32 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.hasVertex(head) {
+		c.read(c.vertices[head].usage)
+	}
+	edges := make(map[S]float32, len(c.edges.in[head]))
+	for tail := range c.edges.in[head] {
+		if w, ok := c.edges.get(tail, head); ok {
+			edges[tail] = w
+			c.read(&c.edges.tf[tail][head].usage)
+		}
+	}
+	return edges
+}
+
+// AddVertexWithExpiration adds a vertex, and evicts vertices and edges if the cache exceeds
+// its limits.
+func (c *GraphCache[S, T]) AddVertexWithExpiration(key S, value T, expiration time.Time) {
+	c.AddVertexWithSlidingExpiration(key, value, expiration, 0)
+}
+
+// AddVertexWithSlidingExpiration adds a vertex like AddVertexWithExpiration, whose expiration
+// is extended to slidingTTL after each read. The sliding TTL of the cache is used if slidingTTL is 0.
+func (c *GraphCache[S, T]) AddVertexWithSlidingExpiration(key S, value T, expiration time.Time, slidingTTL time.Duration) {
+	c.mu.Lock()
+	c.setVertex(key, value, expiration, c.sliding(slidingTTL))
+	vertices, edges := c.evict()
+	hooks := c.onEvict
+	c.mu.Unlock()
+
+	notify(hooks, vertices, edges)
+}
+
+func (c *GraphCache[S, T]) setVertex(key S, value T, expiration time.Time, slidingTTL time.Duration) {
+	u := new(usage)
+	if old, ok := c.vertices[key]; ok {
+		c.vertexBytes -= c.vertexSize(key, old)
+		u = old.usage
+	}
+	v := volatile[T]{
+		value:      value,
+		expiration: expiration,
+		usage:      u,
+	}
+	c.vertices[key] = v
+	c.vertexBytes += c.vertexSize(key, v)
+	u.sliding = slidingTTL
+	c.touch(u)
+	c.expiries.push(expiry[S]{at: expiration.UnixNano(), kind: expiryVertex, tail: key})
+}
+
+func (c *GraphCache[S, T]) removeVertex(key S) {
+	if v, ok := c.vertices[key]; ok {
+		c.vertexBytes -= c.vertexSize(key, v)
+		delete(c.vertices, key)
+	}
+}
+
+func (c *GraphCache[S, T]) AddVertexWithTTL(key S, value T, ttl time.Duration) {
+	c.AddVertexWithExpiration(key, value, time.Now().Add(ttl))
+}
+
+func (c *GraphCache[S, T]) PutVertex(key S, value T) {
+	c.AddVertexWithTTL(key, value, c.defaultTTL)
+}
+
+// AddEdgeWithExpiration adds a weight to an edge, creating its tail and head if they do not
+// exist, and evicts vertices and edges if the cache exceeds its limits, including the maximum
+// out-degree of tail.
+func (c *GraphCache[S, T]) AddEdgeWithExpiration(tail, head S, w float32, expiration time.Time) {
+	c.AddEdgeWithSlidingExpiration(tail, head, w, expiration, 0)
+}
+
+// AddEdgeWithSlidingExpiration adds a weight to an edge like AddEdgeWithExpiration, whose
+// expiration is extended to slidingTTL after each read of the edge. The sliding TTL of the cache
+// is used if slidingTTL is 0. Tail and head created by the edge slide by the sliding TTL of the cache.
+func (c *GraphCache[S, T]) AddEdgeWithSlidingExpiration(tail, head S, w float32, expiration time.Time, slidingTTL time.Duration) {
+	c.AddEdgeAddedAt(tail, head, w, expiration, slidingTTL, time.Now())
+}
+
+// AddEdgeAddedAt adds a weight to an edge like AddEdgeWithSlidingExpiration, which decays from
+// added instead of now, so that weights replayed or restored decay from when they were added.
+func (c *GraphCache[S, T]) AddEdgeAddedAt(tail, head S, w float32, expiration time.Time, slidingTTL time.Duration, added time.Time) {
+	c.mu.Lock()
+	c.addEdge(tail, head, w, expiration, c.sliding(slidingTTL), added)
+	capped := c.capOutDegree(tail)
+	vertices, edges := c.evict()
+	edges = append(capped, edges...)
+	hooks := c.onEvict
+	c.mu.Unlock()
+
+	notify(hooks, vertices, edges)
+}
+
+func (c *GraphCache[S, T]) addEdge(tail, head S, w float32, expiration time.Time, slidingTTL time.Duration, added time.Time) {
+	var noop T
+	if !c.hasVertex(tail) {
+		c.setVertex(tail, noop, expiration, c.slidingTTL)
+	}
+	if !c.hasVertex(head) {
+		c.setVertex(head, noop, expiration, c.slidingTTL)
+	}
+	c.edges.addWithExpiration(tail, head, w, expiration, added)
+	c.edges.tf[tail][head].usage.sliding = slidingTTL
+	c.touch(&c.edges.tf[tail][head].usage)
+	c.expiries.push(expiry[S]{at: expiration.UnixNano(), kind: expiryEdge, tail: tail, head: head})
+}
+
+func (c *GraphCache[S, T]) AddEdgeWithTTL(tail, head S, w float32, ttl time.Duration) {
+	c.AddEdgeWithExpiration(tail, head, w, time.Now().Add(ttl))
+}
+
+func (c *GraphCache[S, T]) AddEdge(tail, head S, w float32) {
+	c.AddEdgeWithTTL(tail, head, w, c.defaultTTL)
+}
+
+// DeleteVertex deletes a vertex together with the edges from or to it, and returns the edges.
+func (c *GraphCache[S, T]) DeleteVertex(key S) []Edge[S] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeVertex(key)
+	return c.edges.deleteAll(key)
+}
+
+func (c *GraphCache[S, T]) DeleteEdge(tail, head S) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.edges.delete(tail, head)
+}
+
+// Clear deletes all vertices and edges.
+func (c *GraphCache[S, T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.vertices = make(map[S]volatile[T])
+	c.vertexBytes = 0
+	halfLife := c.edges.halfLife
+	c.edges = newEdgeCache[S](c.keySize)
+	c.edges.halfLife = halfLife
+	c.expiries = newExpiryQueue[S]()
+}
+
+// Export returns a point-in-time copy of all vertices and edges which have not expired yet.
+// Weights of edges are decayed until now, so that they decay on when they are added again.
+func (c *GraphCache[S, T]) Export() ([]Vertex[S, T], []Edge[S]) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	vertices := make([]Vertex[S, T], 0, len(c.vertices))
+	for k, v := range c.vertices {
+		if v.expired(now) {
+			continue
+		}
+		vertices = append(vertices, Vertex[S, T]{
+			Key:        k,
+			Value:      v.value,
+			Expiration: v.expiresAt(),
+			SlidingTTL: v.usage.sliding,
+		})
+	}
+
+	var edges []Edge[S]
+	for tail, heads := range c.edges.tf {
+		for head, w := range heads {
+			for _, v := range w.values {
+				if w.expired(v, now) {
+					continue
+				}
+				edges = append(edges, c.edges.export(tail, head, v, now))
+			}
+		}
+	}
+	return vertices, edges
+}
+
+// Size returns the numbers of vertices and edges stored, including ones which have expired
+// but have not been swept yet.
+func (c *GraphCache[S, T]) Size() (int, int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.vertices), c.edges.count
+}
+
+// RangeVertices calls fn for each vertex which has not expired yet, in no particular order,
+// until fn returns false. fn must not modify the cache.
+func (c *GraphCache[S, T]) RangeVertices(fn func(v Vertex[S, T]) bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	for k, v := range c.vertices {
+		if v.expired(now) {
+			continue
+		}
+		if !fn(Vertex[S, T]{Key: k, Value: v.value, Expiration: v.expiresAt(), SlidingTTL: v.usage.sliding}) {
+			return
+		}
+	}
+}
+
+// OnExpire adds a function called with vertices and edges removed by the sweeper of Watch.
+// Edges are removed when their weight expires, or when their tail or head does.
+// Functions are called in the order they are added, outside the lock of the cache.
+func (c *GraphCache[S, T]) OnExpire(fn func(vertices []Vertex[S, T], edges []Edge[S])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onExpire = append(c.onExpire, fn)
+}
+
+func (c *GraphCache[S, T]) flush() {
+	vertices, edges, hooks := c.expire()
+	notify(hooks, vertices, edges)
+}
+
+// expire removes vertices and edges whose deadlines have passed. Edges from or to a vertex
+// which no longer exists are removed as well.
+func (c *GraphCache[S, T]) expire() ([]Vertex[S, T], []Edge[S], []func([]Vertex[S, T], []Edge[S])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	var vertices []Vertex[S, T]
+	var edges []Edge[S]
+	for {
+		e, ok := c.expiries.popDue(now.UnixNano())
+		if !ok {
+			break
+		}
+
+		switch e.kind {
+		case expiryVertex:
+			v, ok := c.vertices[e.tail]
+			if ok && !v.expired(now) {
+				// Wait for the vertex again if writes or reads have extended its expiration.
+				c.expiries.push(expiry[S]{at: v.expiresAt().UnixNano(), kind: expiryVertex, tail: e.tail})
+				continue
+			}
+			if ok {
+				vertices = append(vertices, Vertex[S, T]{
+					Key:        e.tail,
+					Value:      v.value,
+					Expiration: v.expiresAt(),
+					SlidingTTL: v.usage.sliding,
+				})
+				c.removeVertex(e.tail)
+			}
+			edges = append(edges, c.edges.deleteAll(e.tail)...)
+
+		case expiryEdge:
+			edges = append(edges, c.edges.flush(e.tail, e.head, now)...)
+			// Wait for the edge again until the next of its values expires.
+			if w, ok := c.edges.tf[e.tail][e.head]; ok {
+				c.expiries.push(expiry[S]{at: w.nextExpiration().UnixNano(), kind: expiryEdge, tail: e.tail, head: e.head})
+			}
+		}
+	}
+	return vertices, edges, c.onExpire
+}
+
+// Direction is a direction in which edges are followed from a vertex.
+type Direction int
+
+const (
+	// Outbound follows edges from tail to head.
+	Outbound Direction = iota
+	// Inbound follows edges from head to tail.
+	Inbound
+	// Both follows edges in both directions.
+	Both
+)
+
+type pair[S comparable] struct {
+	tail S
+	head S
+}
+
+func (c *GraphCache[S, T]) Neighbor(seed S, step int, k int, tfidf bool) *model.Graph[S, T] {
+	return c.NeighborWithDirection(seed, step, k, tfidf, Outbound)
+}
+
+// NeighborWithDirection returns a subgraph explored from seed up to step hops, following top k
+// edges of each vertex in direction. Edges of the subgraph keep their own direction, e.g.
+// all of them point to seed if direction is Inbound and step is 1.
+func (c *GraphCache[S, T]) NeighborWithDirection(seed S, step int, k int, tfidf bool, direction Direction) *model.Graph[S, T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	g := model.NewGraph[S, T]()
+
+	if v, ok := c.getVertex(seed); !ok {
+		return g
+	} else {
+		g.Vertices[seed] = v
+	}
+
+	targets := []S{seed}
+	seen := set.NewSet[S]()
+	for i := 0; i < step && len(targets) > 0; i++ {
+		var next []S
+		for _, key := range targets {
+			// Skip if already seen
+			if seen.Has(key) {
+				continue
+			}
+			seen.Add(key)
+
+			for _, e := range c.adjacent(key, k, tfidf, direction) {
+				if _, ok := g.Edges[e.Tail]; !ok {
+					g.Edges[e.Tail] = make(map[S]float32)
+				}
+				g.Edges[e.Tail][e.Head] = e.Weight
+				c.read(&c.edges.tf[e.Tail][e.Head].usage)
+
+				// Find next targets
+				other := e.Head
+				if other == key {
+					other = e.Tail
+				}
+				if !seen.Has(other) {
+					next = append(next, other)
+				}
+			}
+		}
+		targets = next
+	}
+
+	// Add vertices to the graph
+	for tail, heads := range g.Edges {
+		g.Vertices[tail], _ = c.getVertex(tail)
+		for head := range heads {
+			g.Vertices[head], _ = c.getVertex(head)
+		}
+	}
+	for key := range g.Vertices {
+		if c.hasVertex(key) {
+			c.read(c.vertices[key].usage)
+		}
+	}
+
+	return g
+}
+
+// Adjacent returns top k edges from or to key in direction, which count as reads of them.
+func (c *GraphCache[S, T]) Adjacent(key S, k int, tfidf bool, direction Direction) []Edge[S] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	edges := c.adjacent(key, k, tfidf, direction)
+	for _, e := range edges {
+		c.read(&c.edges.tf[e.Tail][e.Head].usage)
+	}
+	return edges
+}
+
+func (c *GraphCache[S, T]) adjacent(key S, k int, tfidf bool, direction Direction) []Edge[S] {
+	edges := pq.SortableMap[pair[S], float32]{}
+	add := func(tail, head S, w *weight) {
+		value := c.edges.value(w)
+		if value == 0 {
+			return
+		}
+		if tfidf {
+			df := c.edges.df(head)
+			value = value / float32(math.Log2(float64(1+df)))
+		}
+		edges[pair[S]{tail: tail, head: head}] = value
+	}
+
+	if direction == Outbound || direction == Both {
+		for head, w := range c.edges.tf[key] {
+			add(key, head, w)
+		}
+	}
+	if direction == Inbound || direction == Both {
+		for tail := range c.edges.in[key] {
+			add(tail, key, c.edges.tf[tail][key])
+		}
+	}
+
+	// Filter light edges
+	top := edges.Top(k)
+	result := make([]Edge[S], 0, len(top))
+	for p, value := range top {
+		result = append(result, Edge[S]{
+			Tail:   p.tail,
+			Head:   p.head,
+			Weight: value,
+		})
+	}
+	return result
+}
+
+func (c *GraphCache[S, T]) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.flush()
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
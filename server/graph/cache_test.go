@@ -0,0 +1,326 @@
+package graph
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestGraphCache_OnExpire(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	now := time.Now()
+	c.AddVertexWithExpiration("a", 1, now.Add(time.Hour))
+	c.AddVertexWithExpiration("b", 2, now.Add(time.Hour))
+	c.AddEdgeWithExpiration("a", "b", 1, now.Add(-time.Second))
+	c.AddEdgeWithExpiration("a", "b", 2, now.Add(time.Hour))
+	c.AddEdgeWithExpiration("a", "c", 4, now.Add(time.Hour))
+	c.AddVertexWithExpiration("c", 3, now.Add(-time.Second))
+
+	var calls int
+	var vertices []Vertex[string, int]
+	var edges []Edge[string]
+	for i := 0; i < 2; i++ {
+		c.OnExpire(func(v []Vertex[string, int], e []Edge[string]) {
+			calls++
+			vertices, edges = v, e
+		})
+	}
+	c.flush()
+
+	if calls != 2 {
+		t.Errorf("OnExpire() functions are called %d times, want 2", calls)
+	}
+	if len(vertices) != 1 || vertices[0].Key != "c" {
+		t.Errorf("expired vertices = %v, want [c]", vertices)
+	}
+	// a->b loses its expired weight, and a->c is removed with its head.
+	want := map[string]float32{"a->b": 1, "a->c": 4}
+	got := make(map[string]float32)
+	for _, e := range edges {
+		got[e.Tail+"->"+e.Head] += e.Weight
+	}
+	if len(got) != len(want) || got["a->b"] != want["a->b"] || got["a->c"] != want["a->c"] {
+		t.Errorf("expired edges = %v, want %v", got, want)
+	}
+	if w, ok := c.GetWeight("a", "b"); !ok || w != 2 {
+		t.Errorf("GetWeight(a, b) = %v, %v, want 2, true", w, ok)
+	}
+
+	calls = 0
+	c.flush()
+	if calls != 0 {
+		t.Errorf("OnExpire() functions are called without expiration")
+	}
+}
+
+func TestGraphCache_DeleteVertex(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	c.AddEdge("a", "b", 1)
+	c.AddEdge("c", "a", 2)
+	c.AddEdge("b", "c", 4)
+
+	expired := 0
+	c.OnExpire(func(v []Vertex[string, int], e []Edge[string]) {
+		expired += len(v) + len(e)
+	})
+	var deleted []string
+	for _, e := range c.DeleteVertex("a") {
+		deleted = append(deleted, e.Tail+"->"+e.Head)
+	}
+
+	// Edges from and to a are deleted at once rather than left to the sweeper.
+	sort.Strings(deleted)
+	if want := []string{"a->b", "c->a"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted edges = %v, want %v", deleted, want)
+	}
+	if in, out := c.InEdges("a"), c.OutEdges("a"); len(in) > 0 || len(out) > 0 {
+		t.Errorf("edges of a = %v, %v, want none", in, out)
+	}
+	if _, ok := c.GetWeight("b", "c"); !ok {
+		t.Errorf("GetWeight(b, c) is deleted")
+	}
+	if _, edges := c.Size(); edges != 1 {
+		t.Errorf("Size() edges = %d, want 1", edges)
+	}
+	c.flush()
+	if expired != 0 {
+		t.Errorf("%d vertices and edges expire after DeleteVertex(a), want none", expired)
+	}
+
+	if edges := c.DeleteVertex("missing"); len(edges) > 0 {
+		t.Errorf("DeleteVertex(missing) = %v, want none", edges)
+	}
+}
+
+func TestGraphCache_flush(t *testing.T) {
+	past := time.Now().Add(-time.Second)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		setup        func(c *GraphCache[string, int])
+		wantVertices []string
+		wantEdges    []string
+	}{
+		{
+			name: "superseded deadline",
+			setup: func(c *GraphCache[string, int]) {
+				c.AddVertexWithExpiration("a", 1, past)
+				c.AddVertexWithExpiration("a", 1, future)
+			},
+			wantVertices: []string{"a"},
+		},
+		{
+			name: "expired head",
+			setup: func(c *GraphCache[string, int]) {
+				c.AddEdgeWithExpiration("a", "b", 1, future)
+				c.AddEdgeWithExpiration("c", "b", 1, future)
+				c.AddVertexWithExpiration("b", 1, past)
+			},
+			wantVertices: []string{"a", "c"},
+		},
+		{
+			name: "deleted tail",
+			setup: func(c *GraphCache[string, int]) {
+				c.AddEdgeWithExpiration("a", "b", 1, future)
+				c.AddEdgeWithExpiration("b", "c", 1, future)
+				c.DeleteVertex("a")
+			},
+			wantVertices: []string{"b", "c"},
+			wantEdges:    []string{"b->c"},
+		},
+		{
+			name: "self loop",
+			setup: func(c *GraphCache[string, int]) {
+				c.AddEdgeWithExpiration("a", "a", 1, future)
+				c.AddVertexWithExpiration("a", 1, past)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewGraphCache[string, int](time.Minute)
+			tt.setup(c)
+			time.Sleep(time.Millisecond)
+			c.flush()
+
+			vertices, edges := c.Export()
+			var gotVertices, gotEdges []string
+			for _, v := range vertices {
+				gotVertices = append(gotVertices, v.Key)
+			}
+			for _, e := range edges {
+				gotEdges = append(gotEdges, e.Tail+"->"+e.Head)
+			}
+			sort.Strings(gotVertices)
+			sort.Strings(gotEdges)
+			if !reflect.DeepEqual(gotVertices, tt.wantVertices) {
+				t.Errorf("vertices = %v, want %v", gotVertices, tt.wantVertices)
+			}
+			if !reflect.DeepEqual(gotEdges, tt.wantEdges) {
+				t.Errorf("edges = %v, want %v", gotEdges, tt.wantEdges)
+			}
+			if len(tt.wantEdges) == 0 && (len(c.edges.tf) > 0 || len(c.edges.in) > 0) {
+				t.Errorf("edges are left in the index: %v, %v", c.edges.tf, c.edges.in)
+			}
+		})
+	}
+}
+
+func TestGraphCache_expiries(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	now := time.Now()
+	for i := 1; i <= 1000; i++ {
+		c.AddVertexWithExpiration("a", i, now.Add(time.Duration(i)*time.Second))
+		c.AddEdgeWithExpiration("a", "b", 1, now.Add(time.Duration(i)*time.Second))
+		c.TouchVertex("a", now.Add(time.Duration(i)*time.Hour))
+	}
+	// Deadlines of a, b and a->b, which are not pushed again when they are extended.
+	if n := c.expiries.Len(); n != 3 {
+		t.Errorf("expiries.Len() = %d, want 3", n)
+	}
+
+	// A vertex extended by a write is swept at its extended expiration.
+	c.AddVertexWithExpiration("c", 1, time.Now().Add(10*time.Millisecond))
+	c.AddVertexWithExpiration("c", 2, time.Now().Add(50*time.Millisecond))
+	time.Sleep(20 * time.Millisecond)
+	c.flush()
+	if _, ok := c.vertices["c"]; !ok {
+		t.Fatalf("c is swept before its extended expiration")
+	}
+	time.Sleep(40 * time.Millisecond)
+	c.flush()
+	if _, ok := c.vertices["c"]; ok {
+		t.Errorf("c is not swept after its extended expiration")
+	}
+}
+
+func TestGraphCache_SetHalfLife(t *testing.T) {
+	c := NewGraphCache[string, int](time.Hour)
+	c.SetHalfLife(time.Minute)
+	c.AddEdge("a", "b", 4)
+	c.AddEdge("a", "c", 1)
+	// Let the weight of a->b age by 3 half-lives.
+	c.edges.tf["a"]["b"].values[0].added -= int64(3 * time.Minute)
+
+	if w, ok := c.GetWeight("a", "b"); !ok || math.Abs(float64(w)-0.5) > 1e-3 {
+		t.Errorf("GetWeight(a, b) = %v, %v, want 0.5, true", w, ok)
+	}
+	g := c.Neighbor("a", 1, 1, false)
+	if _, ok := g.Edges["a"]["c"]; !ok || len(g.Edges["a"]) != 1 {
+		t.Errorf("Neighbor(a) = %v, want a->c", g.Edges)
+	}
+
+	// An addition is applied to the decayed weight.
+	c.AddEdge("a", "b", 1)
+	if w, _ := c.GetWeight("a", "b"); math.Abs(float64(w)-1.5) > 1e-3 {
+		t.Errorf("GetWeight(a, b) after AddEdge() = %v, want 1.5", w)
+	}
+	_, edges := c.Export()
+	var exported float32
+	for _, e := range edges {
+		if e.Head == "b" {
+			exported += e.Weight
+		}
+	}
+	if math.Abs(float64(exported)-1.5) > 1e-3 {
+		t.Errorf("Export() a->b = %v, want 1.5", exported)
+	}
+
+	c.SetHalfLife(0)
+	if w, _ := c.GetWeight("a", "b"); w != 5 {
+		t.Errorf("GetWeight(a, b) without decay = %v, want 5", w)
+	}
+}
+
+func TestGraphCache_SlidingExpiration(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	now := time.Now()
+	c.AddVertexWithSlidingExpiration("a", 1, now.Add(50*time.Millisecond), time.Hour)
+	c.AddVertexWithExpiration("b", 2, now.Add(50*time.Millisecond))
+	c.AddEdgeWithSlidingExpiration("a", "b", 1, now.Add(50*time.Millisecond), time.Hour)
+
+	c.GetVertex("a")
+	c.GetWeight("a", "b")
+	time.Sleep(100 * time.Millisecond)
+	c.flush()
+
+	if _, ok := c.GetVertex("a"); !ok {
+		t.Errorf("GetVertex(a) is expired, want extended by a read")
+	}
+	if _, ok := c.GetVertex("b"); ok {
+		t.Errorf("GetVertex(b) is not expired")
+	}
+	vertices, _ := c.Export()
+	if len(vertices) != 1 || vertices[0].SlidingTTL != time.Hour || vertices[0].Expiration.Before(now.Add(time.Hour)) {
+		t.Errorf("Export() = %v, want a extended by an hour", vertices)
+	}
+
+	c.SetSlidingTTL(time.Hour)
+	c.AddEdgeWithExpiration("a", "c", 1, now.Add(time.Minute))
+	c.OutEdges("a")
+	if _, edges := c.Export(); len(edges) != 1 || edges[0].SlidingTTL != time.Hour || edges[0].Expiration.Before(now.Add(time.Hour)) {
+		t.Errorf("Export() = %v, want a->c extended by an hour", edges)
+	}
+}
+
+func TestGraphCache_Touch(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	now := time.Now()
+	c.AddVertexWithExpiration("a", 1, now.Add(time.Minute))
+	c.AddEdgeWithExpiration("a", "b", 2, now.Add(time.Minute))
+
+	if !c.TouchVertex("a", now.Add(time.Hour)) || c.TouchVertex("x", now.Add(time.Hour)) {
+		t.Errorf("TouchVertex() reports a wrong vertex")
+	}
+	if !c.TouchEdge("a", "b", now.Add(time.Hour)) || c.TouchEdge("b", "a", now.Add(time.Hour)) {
+		t.Errorf("TouchEdge() reports a wrong edge")
+	}
+	// An earlier expiration does not shorten the current one.
+	c.TouchVertex("a", now.Add(time.Second))
+
+	vertices, edges := c.Export()
+	for _, v := range vertices {
+		if v.Key == "a" && (v.Value != 1 || !v.Expiration.Equal(now.Add(time.Hour))) {
+			t.Errorf("Export() a = %v, want expiration in an hour", v)
+		}
+	}
+	if len(edges) != 1 || edges[0].Weight != 2 || !edges[0].Expiration.Equal(now.Add(time.Hour)) {
+		t.Errorf("Export() edges = %v, want a->b expiring in an hour", edges)
+	}
+}
+
+func TestGraphCache_SlidingExpiration_expired(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	now := time.Now()
+	c.AddVertexWithSlidingExpiration("a", 1, now.Add(-time.Second), time.Hour)
+	c.AddEdgeWithSlidingExpiration("a", "b", 1, now.Add(-time.Second), time.Hour)
+	c.AddEdgeWithSlidingExpiration("b", "c", 1, now.Add(-time.Second), time.Hour)
+	c.AddEdgeWithSlidingExpiration("b", "c", 2, now.Add(time.Minute), time.Hour)
+
+	// Reads of entries which have expired but are not swept yet do not bring them back.
+	for i := 0; i < 2; i++ {
+		if _, ok := c.GetVertex("a"); ok {
+			t.Errorf("GetVertex(a) is found, want expired")
+		}
+		if _, ok := c.GetWeight("a", "b"); ok {
+			t.Errorf("GetWeight(a, b) is found, want expired")
+		}
+		if w, ok := c.GetWeight("b", "c"); !ok || w != 2 {
+			t.Errorf("GetWeight(b, c) = %v, %v, want 2, true", w, ok)
+		}
+		c.OutEdges("a")
+		c.InEdges("b")
+	}
+	vertices, edges := c.Export()
+	for _, v := range vertices {
+		if v.Key == "a" {
+			t.Errorf("Export() = %v, want a expired", v)
+		}
+	}
+	if len(edges) != 1 || edges[0].Weight != 2 {
+		t.Errorf("Export() edges = %v, want only b->c of 2", edges)
+	}
+}
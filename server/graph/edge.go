@@ -0,0 +1,287 @@
+package graph
+
+import (
+	"math"
+	"time"
+	"unsafe"
+)
+
+type weightValue struct {
+	value      float32
+	expiration time.Time
+	// added is when the value is added in Unix nanoseconds, from which it decays.
+	added int64
+}
+
+// decayed returns the value halved every halfLife since it is added, or the value itself
+// if halfLife is 0.
+func (w weightValue) decayed(now time.Time, halfLife time.Duration) float32 {
+	if halfLife <= 0 {
+		return w.value
+	}
+	age := float64(now.UnixNano()-w.added) / float64(halfLife)
+	return w.value * float32(math.Exp2(-math.Max(age, 0)))
+}
+
+type weight struct {
+	values []weightValue
+	usage  usage
+	// seq is the order in which a value is added to the edge last.
+	seq uint64
+}
+
+func newWeight() *weight {
+	return &weight{
+		values: make([]weightValue, 0),
+	}
+}
+
+// value returns the sum of values which have not expired, each decayed by halfLife. Since
+// decay is linear, it equals the decayed sum of earlier values plus the latest value.
+func (w *weight) value(now time.Time, halfLife time.Duration) float32 {
+	var sum float32
+	for _, v := range w.values {
+		if !w.expired(v, now) {
+			sum += v.decayed(now, halfLife)
+		}
+	}
+	return sum
+}
+
+// expiresAt returns when a value expires, which is extended by the sliding TTL of the edge.
+func (w *weight) expiresAt(v weightValue) time.Time {
+	return w.usage.expiresAt(v.expiration)
+}
+
+func (w *weight) expired(v weightValue, now time.Time) bool {
+	return now.After(w.expiresAt(v))
+}
+
+// expiration returns when the last value expires.
+func (w *weight) expiration() time.Time {
+	var last time.Time
+	for _, v := range w.values {
+		if at := w.expiresAt(v); at.After(last) {
+			last = at
+		}
+	}
+	return last
+}
+
+// nextExpiration returns when the first value expires.
+func (w *weight) nextExpiration() time.Time {
+	var first time.Time
+	for _, v := range w.values {
+		if at := w.expiresAt(v); first.IsZero() || at.Before(first) {
+			first = at
+		}
+	}
+	return first
+}
+
+func (w *weight) addWithExpiration(value float32, expiration time.Time, added time.Time) {
+	w.values = append(w.values, weightValue{
+		value:      value,
+		expiration: expiration,
+		added:      added.UnixNano(),
+	})
+}
+
+// isZero reports whether the values which have not expired sum up to 0 without decay.
+func (w *weight) isZero() bool {
+	return w.value(time.Now(), 0) == 0
+}
+
+// flush removes expired values, and returns them.
+func (w *weight) flush(now time.Time) []weightValue {
+	var expired []weightValue
+	v := make([]weightValue, 0, len(w.values))
+	for _, value := range w.values {
+		if w.expired(value, now) {
+			expired = append(expired, value)
+		} else {
+			v = append(v, value)
+		}
+	}
+	w.values = v
+	return expired
+}
+
+// edgeCache is not goroutine safe, GraphCache guards it with its own lock.
+type edgeCache[S comparable] struct {
+	// tf is weights of edges indexed by tail and head.
+	tf map[S]map[S]*weight
+	// in is tails of edges indexed by head, whose size is the document frequency of head.
+	in map[S]map[S]struct{}
+
+	// count, values and keyBytes are the numbers of edges, of their values, and of bytes
+	// used by their keys, kept up to date for the limits of GraphCache.
+	count    int
+	values   int
+	keyBytes int64
+	keySize  func(key S) int64
+	// seq counts values added to edges.
+	seq uint64
+	// halfLife is the half-life of values, which do not decay if it is 0.
+	halfLife time.Duration
+}
+
+func newEdgeCache[S comparable](keySize func(key S) int64) *edgeCache[S] {
+	return &edgeCache[S]{
+		tf:      make(map[S]map[S]*weight),
+		in:      make(map[S]map[S]struct{}),
+		keySize: keySize,
+	}
+}
+
+// edgeSize returns bytes used by an edge besides its values. Keys are counted in both tf and in.
+func (c *edgeCache[S]) edgeSize(tail, head S) int64 {
+	return 2*(c.keySize(tail)+c.keySize(head)) + int64(unsafe.Sizeof(&weight{})+unsafe.Sizeof(weight{}))
+}
+
+// bytes returns the approximate number of bytes used by edges.
+func (c *edgeCache[S]) bytes() int64 {
+	return c.keyBytes + int64(c.values)*int64(unsafe.Sizeof(weightValue{})) +
+		int64(c.count)*int64(unsafe.Sizeof(&weight{})+unsafe.Sizeof(weight{}))
+}
+
+// resize recounts edges, their values and the bytes of their keys, e.g. after keySize changes.
+func (c *edgeCache[S]) resize() {
+	c.count, c.values, c.keyBytes = 0, 0, 0
+	for tail, heads := range c.tf {
+		for head, w := range heads {
+			c.count++
+			c.values += len(w.values)
+			c.keyBytes += 2 * (c.keySize(tail) + c.keySize(head))
+		}
+	}
+}
+
+func (c *edgeCache[S]) df(head S) int {
+	return len(c.in[head])
+}
+
+func (c *edgeCache[S]) get(tail, head S) (float32, bool) {
+	if _, ok := c.tf[tail]; !ok {
+		return 0, false
+	}
+
+	w, ok := c.tf[tail][head]
+	if !ok {
+		return 0, false
+	}
+	if v := c.value(w); v != 0 {
+		return v, true
+	}
+	return 0, false
+}
+
+// value returns the current weight of an edge.
+func (c *edgeCache[S]) value(w *weight) float32 {
+	return w.value(time.Now(), c.halfLife)
+}
+
+// export returns a copy of a value of an edge, whose weight is decayed until now.
+func (c *edgeCache[S]) export(tail, head S, v weightValue, now time.Time) Edge[S] {
+	w := c.tf[tail][head]
+	return Edge[S]{
+		Tail:       tail,
+		Head:       head,
+		Weight:     v.decayed(now, c.halfLife),
+		Expiration: w.expiresAt(v),
+		SlidingTTL: w.usage.sliding,
+		Added:      now,
+	}
+}
+
+func (c *edgeCache[S]) addWithExpiration(tail, head S, w float32, expiration time.Time, added time.Time) {
+	if _, ok := c.tf[tail]; !ok {
+		c.tf[tail] = make(map[S]*weight)
+	}
+
+	if _, ok := c.tf[tail][head]; !ok {
+		c.tf[tail][head] = newWeight()
+		if _, ok := c.in[head]; !ok {
+			c.in[head] = make(map[S]struct{})
+		}
+		c.in[head][tail] = struct{}{}
+		c.count++
+		c.keyBytes += 2 * (c.keySize(tail) + c.keySize(head))
+	}
+
+	c.seq++
+	c.tf[tail][head].addWithExpiration(w, expiration, added)
+	c.tf[tail][head].seq = c.seq
+	c.values++
+}
+
+func (c *edgeCache[S]) delete(tail, head S) {
+	if _, ok := c.tf[tail]; !ok {
+		return
+	}
+
+	w, ok := c.tf[tail][head]
+	if !ok {
+		return
+	}
+
+	c.count--
+	c.values -= len(w.values)
+	c.keyBytes -= 2 * (c.keySize(tail) + c.keySize(head))
+	delete(c.tf[tail], head)
+	delete(c.in[head], tail)
+	if len(c.in[head]) == 0 {
+		delete(c.in, head)
+	}
+	if len(c.tf[tail]) == 0 {
+		delete(c.tf, tail)
+	}
+}
+
+// flush removes expired values of an edge, and returns them.
+// The edge is deleted if no value remains.
+func (c *edgeCache[S]) flush(tail, head S, now time.Time) []Edge[S] {
+	w, ok := c.tf[tail][head]
+	if !ok {
+		return nil
+	}
+
+	var expired []Edge[S]
+	values := w.flush(now)
+	c.values -= len(values)
+	for _, v := range values {
+		expired = append(expired, c.export(tail, head, v, now))
+	}
+	if w.isZero() {
+		c.delete(tail, head)
+	}
+	return expired
+}
+
+// deleteEdge deletes an edge, and returns its values.
+func (c *edgeCache[S]) deleteEdge(tail, head S) []Edge[S] {
+	w, ok := c.tf[tail][head]
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	deleted := make([]Edge[S], 0, len(w.values))
+	for _, v := range w.values {
+		deleted = append(deleted, c.export(tail, head, v, now))
+	}
+	c.delete(tail, head)
+	return deleted
+}
+
+// deleteAll deletes all edges from or to key, and returns their values.
+func (c *edgeCache[S]) deleteAll(key S) []Edge[S] {
+	var deleted []Edge[S]
+	for head := range c.tf[key] {
+		deleted = append(deleted, c.deleteEdge(key, head)...)
+	}
+	for tail := range c.in[key] {
+		deleted = append(deleted, c.deleteEdge(tail, key)...)
+	}
+	return deleted
+}
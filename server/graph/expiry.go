@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"container/heap"
+	"context"
+	"time"
+)
+
+type expiryKind uint8
+
+const (
+	expiryVertex expiryKind = iota
+	expiryEdge
+)
+
+// expiry is a deadline of a vertex or an edge. A vertex is identified by tail.
+type expiry[S comparable] struct {
+	at   int64
+	kind expiryKind
+	tail S
+	head S
+}
+
+// key identifies the vertex or the edge of a deadline.
+func (e expiry[S]) key() expiry[S] {
+	return expiry[S]{kind: e.kind, tail: e.tail, head: e.head}
+}
+
+// expiryHeap is a min-heap of deadlines.
+type expiryHeap[S comparable] []expiry[S]
+
+func (h expiryHeap[S]) Len() int           { return len(h) }
+func (h expiryHeap[S]) Less(i, j int) bool { return h[i].at < h[j].at }
+func (h expiryHeap[S]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *expiryHeap[S]) Push(x any) {
+	*h = append(*h, x.(expiry[S]))
+}
+
+func (h *expiryHeap[S]) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// expiryQueue lets the sweeper visit only vertices and edges close to their deadlines. It keeps
+// the earliest deadline of each vertex and edge, and a later one is not pushed, so that the queue
+// does not grow with writes which extend expirations. The sweeper pushes the current deadline
+// of a vertex or an edge again when it finds it alive at the earliest one.
+type expiryQueue[S comparable] struct {
+	heap expiryHeap[S]
+	// scheduled is the earliest deadline in heap of each vertex and edge. Deadlines in heap
+	// which are not scheduled have been superseded, and are discarded when they are popped.
+	scheduled map[expiry[S]]int64
+}
+
+func newExpiryQueue[S comparable]() *expiryQueue[S] {
+	return &expiryQueue[S]{scheduled: make(map[expiry[S]]int64)}
+}
+
+func (q *expiryQueue[S]) Len() int {
+	return len(q.heap)
+}
+
+// push schedules a deadline unless one which is not later is scheduled already.
+func (q *expiryQueue[S]) push(e expiry[S]) {
+	key := e.key()
+	if at, ok := q.scheduled[key]; ok && at <= e.at {
+		return
+	}
+	q.scheduled[key] = e.at
+	heap.Push(&q.heap, e)
+}
+
+// popDue pops a scheduled deadline which is before now, like volatile.expired.
+func (q *expiryQueue[S]) popDue(now int64) (expiry[S], bool) {
+	for len(q.heap) > 0 && q.heap[0].at < now {
+		e := heap.Pop(&q.heap).(expiry[S])
+		key := e.key()
+		if at, ok := q.scheduled[key]; !ok || at != e.at {
+			continue
+		}
+		delete(q.scheduled, key)
+		return e, true
+	}
+	return expiry[S]{}, false
+}
+
+// Sweeper removes expired vertices and edges from a GraphCache periodically.
+type Sweeper[S comparable, T any] struct {
+	cache    *GraphCache[S, T]
+	interval time.Duration
+	onSweep  []func(time.Duration)
+}
+
+func NewSweeper[S comparable, T any](cache *GraphCache[S, T], interval time.Duration) *Sweeper[S, T] {
+	return &Sweeper[S, T]{
+		cache:    cache,
+		interval: interval,
+	}
+}
+
+// OnSweep adds a function called with the duration of each sweep. It must be called before Watch.
+func (s *Sweeper[S, T]) OnSweep(fn func(d time.Duration)) {
+	s.onSweep = append(s.onSweep, fn)
+}
+
+func (s *Sweeper[S, T]) Watch(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			start := time.Now()
+			s.cache.flush()
+			d := time.Since(start)
+			for _, fn := range s.onSweep {
+				fn(d)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,422 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// Policy chooses what GraphCache does when it exceeds its limits.
+type Policy int
+
+const (
+	// Reject leaves the cache as it is, and lets Fits reject mutations which would exceed the limits.
+	Reject Policy = iota
+	// LRU evicts vertices and edges which are least recently read or written.
+	LRU
+	// LFU evicts vertices and edges which are least frequently read or written.
+	LFU
+	// SoonestExpiring evicts vertices and edges which expire soonest.
+	SoonestExpiring
+	// LowestWeight evicts edges with the lowest weights, and vertices with the lowest sums of
+	// weights of their edges.
+	LowestWeight
+)
+
+// evictionHeadroom is the fraction of a limit freed by an eviction beyond the limit itself,
+// so that vertices and edges are not chosen on every mutation.
+const evictionHeadroom = 0.05
+
+// Rank orders edges from a vertex to keep when it exceeds its maximum out-degree.
+type Rank int
+
+const (
+	// RankWeight keeps edges with the highest weights.
+	RankWeight Rank = iota
+	// RankRecency keeps edges whose weights are added most recently.
+	RankRecency
+)
+
+var (
+	ErrLimitExceeded = errors.New("limit exceeded")
+	ErrUnknownPolicy = errors.New("unknown eviction policy")
+	ErrUnknownRank   = errors.New("unknown rank")
+)
+
+var policies = map[string]Policy{
+	"reject":           Reject,
+	"lru":              LRU,
+	"lfu":              LFU,
+	"soonest-expiring": SoonestExpiring,
+	"lowest-weight":    LowestWeight,
+}
+
+// ParsePolicy parses "reject", "lru", "lfu", "soonest-expiring" or "lowest-weight".
+// An empty string is Reject.
+func ParsePolicy(s string) (Policy, error) {
+	if s == "" {
+		return Reject, nil
+	}
+	if p, ok := policies[s]; ok {
+		return p, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownPolicy, s)
+}
+
+func (p Policy) String() string {
+	switch p {
+	case Reject:
+		return "reject"
+	case LRU:
+		return "lru"
+	case LFU:
+		return "lfu"
+	case SoonestExpiring:
+		return "soonest-expiring"
+	case LowestWeight:
+		return "lowest-weight"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseRank parses "weight" or "recency". An empty string is RankWeight.
+func ParseRank(s string) (Rank, error) {
+	switch s {
+	case "", "weight":
+		return RankWeight, nil
+	case "recency":
+		return RankRecency, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnknownRank, s)
+	}
+}
+
+func (r Rank) String() string {
+	switch r {
+	case RankWeight:
+		return "weight"
+	case RankRecency:
+		return "recency"
+	default:
+		return "unknown"
+	}
+}
+
+// Limits bounds the numbers of vertices and edges of GraphCache, and its approximate memory
+// footprint. A zero limit is unlimited. KeySize and ValueSize return bytes referenced by a key
+// or a value besides its own size, like StatsOptions, and may be nil.
+//
+// MaxOutDegree bounds edges from each vertex regardless of Policy: adding an edge beyond it
+// evicts the weakest edge from its tail according to KeepBy, which may be the edge itself.
+type Limits[S comparable, T any] struct {
+	MaxVertices  int
+	MaxEdges     int
+	MaxBytes     int64
+	Policy       Policy
+	MaxOutDegree int
+	KeepBy       Rank
+	KeySize      func(key S) int
+	ValueSize    func(value T) int
+}
+
+func (l Limits[S, T]) bounded() bool {
+	return l.MaxVertices > 0 || l.MaxEdges > 0 || l.MaxBytes > 0
+}
+
+// usage records reads and writes of a vertex or an edge for LRU and LFU, and its reads for
+// its sliding TTL. It is updated atomically, since reads hold only the read lock of the cache.
+type usage struct {
+	last atomic.Int64
+	hits atomic.Int64
+	// read is the last read, and since is the first of the reads which followed each other
+	// within the sliding TTL until read.
+	read    atomic.Int64
+	since   atomic.Int64
+	sliding time.Duration
+}
+
+func (u *usage) touch(clock int64) {
+	u.last.Store(clock)
+	u.hits.Add(1)
+}
+
+// tracking reports whether reads and writes are recorded for the eviction policy.
+func (c *GraphCache[S, T]) tracking() bool {
+	return c.limits.bounded() && (c.limits.Policy == LRU || c.limits.Policy == LFU)
+}
+
+// EvictsByReads reports whether the cache evicts vertices and edges by how recently or frequently
+// they are read, as LRU and LFU do. Such evictions differ between caches which apply the same
+// mutations, e.g. a leader and its followers, or a cache and the one replaying its log.
+func (c *GraphCache[S, T]) EvictsByReads() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tracking()
+}
+
+func (c *GraphCache[S, T]) touch(u *usage) {
+	if u != nil && c.tracking() {
+		u.touch(c.clock.Add(1))
+	}
+}
+
+func (c *GraphCache[S, T]) keySize(key S) int64 {
+	size := int64(unsafe.Sizeof(key)) + mapEntryOverhead
+	if c.limits.KeySize != nil {
+		size += int64(c.limits.KeySize(key))
+	}
+	return size
+}
+
+func (c *GraphCache[S, T]) vertexSize(key S, v volatile[T]) int64 {
+	size := c.keySize(key) + int64(unsafe.Sizeof(v)) + int64(unsafe.Sizeof(usage{}))
+	if c.limits.ValueSize != nil {
+		size += int64(c.limits.ValueSize(v.value))
+	}
+	return size
+}
+
+// memory returns the approximate number of bytes used by vertices and edges. Deadlines are
+// left out, since those of evicted vertices and edges remain until they are swept.
+func (c *GraphCache[S, T]) memory() int64 {
+	return c.vertexBytes + c.edges.bytes()
+}
+
+// SetLimits bounds the cache, evicting vertices and edges at once if it exceeds the limits
+// and the policy evicts.
+func (c *GraphCache[S, T]) SetLimits(limits Limits[S, T]) {
+	c.mu.Lock()
+	c.limits = limits
+	c.edges.keySize = c.keySize
+	c.vertexBytes = 0
+	for key, v := range c.vertices {
+		c.vertexBytes += c.vertexSize(key, v)
+	}
+	c.edges.resize()
+	var capped []Edge[S]
+	for tail := range c.edges.tf {
+		capped = append(capped, c.capOutDegree(tail)...)
+	}
+	vertices, edges := c.evict()
+	edges = append(capped, edges...)
+	hooks := c.onEvict
+	c.mu.Unlock()
+
+	notify(hooks, vertices, edges)
+}
+
+// Limits returns the limits set by SetLimits.
+func (c *GraphCache[S, T]) Limits() Limits[S, T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.limits
+}
+
+// OnEvict adds a function called with vertices and edges evicted to keep the cache within
+// its limits. Functions are called in the order they are added, outside the lock of the cache.
+func (c *GraphCache[S, T]) OnEvict(fn func(vertices []Vertex[S, T], edges []Edge[S])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onEvict = append(c.onEvict, fn)
+}
+
+func notify[S comparable, T any](hooks []func([]Vertex[S, T], []Edge[S]), vertices []Vertex[S, T], edges []Edge[S]) {
+	if len(vertices) == 0 && len(edges) == 0 {
+		return
+	}
+	for _, fn := range hooks {
+		fn(vertices, edges)
+	}
+}
+
+// Fits returns ErrLimitExceeded if the cache rejects mutations beyond its limits, and adding
+// vertices and edges, including vertices created by edges, would exceed any of them.
+// Vertices and edges which already exist are not counted again.
+func (c *GraphCache[S, T]) Fits(vertices []Vertex[S, T], edges []Edge[S]) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.limits.Policy != Reject || !c.limits.bounded() {
+		return nil
+	}
+
+	added := make(map[S]struct{})
+	var bytes int64
+	addVertex := func(key S, value T) {
+		if _, ok := c.vertices[key]; ok {
+			return
+		}
+		if _, ok := added[key]; ok {
+			return
+		}
+		added[key] = struct{}{}
+		bytes += c.vertexSize(key, volatile[T]{value: value})
+	}
+	for _, v := range vertices {
+		addVertex(v.Key, v.Value)
+	}
+
+	addedEdges := make(map[pair[S]]struct{})
+	for _, e := range edges {
+		var noop T
+		addVertex(e.Tail, noop)
+		addVertex(e.Head, noop)
+		bytes += int64(unsafe.Sizeof(weightValue{}))
+		p := pair[S]{tail: e.Tail, head: e.Head}
+		if _, ok := c.edges.tf[e.Tail][e.Head]; ok {
+			continue
+		}
+		if _, ok := addedEdges[p]; ok {
+			continue
+		}
+		addedEdges[p] = struct{}{}
+		bytes += c.edges.edgeSize(e.Tail, e.Head)
+	}
+
+	if n := len(c.vertices) + len(added); c.limits.MaxVertices > 0 && n > c.limits.MaxVertices {
+		return fmt.Errorf("%w: %d vertices exceed %d", ErrLimitExceeded, n, c.limits.MaxVertices)
+	}
+	if n := c.edges.count + len(addedEdges); c.limits.MaxEdges > 0 && n > c.limits.MaxEdges {
+		return fmt.Errorf("%w: %d edges exceed %d", ErrLimitExceeded, n, c.limits.MaxEdges)
+	}
+	if n := c.memory() + bytes; c.limits.MaxBytes > 0 && n > c.limits.MaxBytes {
+		return fmt.Errorf("%w: %d bytes exceed %d", ErrLimitExceeded, n, c.limits.MaxBytes)
+	}
+	return nil
+}
+
+// target returns the size an eviction reduces to from limit.
+func target[N int | int64](limit N) N {
+	return limit - N(float64(limit)*evictionHeadroom)
+}
+
+// evict removes vertices and edges chosen by the policy until the cache is within its limits
+// with some headroom, and returns them.
+func (c *GraphCache[S, T]) evict() ([]Vertex[S, T], []Edge[S]) {
+	l := c.limits
+	if l.Policy == Reject || !l.bounded() {
+		return nil, nil
+	}
+	overVertices := l.MaxVertices > 0 && len(c.vertices) > l.MaxVertices
+	overEdges := l.MaxEdges > 0 && c.edges.count > l.MaxEdges
+	overBytes := l.MaxBytes > 0 && c.memory() > l.MaxBytes
+	if !overVertices && !overEdges && !overBytes {
+		return nil, nil
+	}
+
+	var vertices []Vertex[S, T]
+	var edges []Edge[S]
+	if overEdges {
+		for _, p := range c.edgeCandidates() {
+			if c.edges.count <= target(l.MaxEdges) {
+				break
+			}
+			edges = append(edges, c.edges.deleteEdge(p.tail, p.head)...)
+		}
+	}
+
+	within := func() bool {
+		return (l.MaxVertices == 0 || len(c.vertices) <= target(l.MaxVertices)) &&
+			(l.MaxBytes == 0 || c.memory() <= target(l.MaxBytes))
+	}
+	if (overVertices || overBytes) && !within() {
+		for _, key := range c.vertexCandidates() {
+			if within() {
+				break
+			}
+			v := c.vertices[key]
+			vertices = append(vertices, Vertex[S, T]{Key: key, Value: v.value, Expiration: v.expiresAt(), SlidingTTL: v.usage.sliding})
+			c.removeVertex(key)
+			edges = append(edges, c.edges.deleteAll(key)...)
+		}
+	}
+	return vertices, edges
+}
+
+// vertexCandidates returns keys of vertices in the order they are evicted by the policy.
+func (c *GraphCache[S, T]) vertexCandidates() []S {
+	keys := make([]S, 0, len(c.vertices))
+	scores := make(map[S]float64, len(c.vertices))
+	for key, v := range c.vertices {
+		keys = append(keys, key)
+		switch c.limits.Policy {
+		case LRU:
+			scores[key] = float64(v.usage.last.Load())
+		case LFU:
+			scores[key] = float64(v.usage.hits.Load())
+		case SoonestExpiring:
+			scores[key] = float64(v.expiresAt().UnixNano())
+		case LowestWeight:
+			var sum float64
+			for _, w := range c.edges.tf[key] {
+				sum += float64(c.edges.value(w))
+			}
+			for tail := range c.edges.in[key] {
+				sum += float64(c.edges.value(c.edges.tf[tail][key]))
+			}
+			scores[key] = sum
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return scores[keys[i]] < scores[keys[j]]
+	})
+	return keys
+}
+
+// edgeCandidates returns edges in the order they are evicted by the policy.
+func (c *GraphCache[S, T]) edgeCandidates() []pair[S] {
+	pairs := make([]pair[S], 0, c.edges.count)
+	scores := make(map[pair[S]]float64, c.edges.count)
+	for tail, heads := range c.edges.tf {
+		for head, w := range heads {
+			p := pair[S]{tail: tail, head: head}
+			pairs = append(pairs, p)
+			switch c.limits.Policy {
+			case LRU:
+				scores[p] = float64(w.usage.last.Load())
+			case LFU:
+				scores[p] = float64(w.usage.hits.Load())
+			case SoonestExpiring:
+				scores[p] = float64(w.expiration().UnixNano())
+			case LowestWeight:
+				scores[p] = float64(c.edges.value(w))
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return scores[pairs[i]] < scores[pairs[j]]
+	})
+	return pairs
+}
+
+// weaker reports whether v is weaker than w by KeepBy.
+func (c *GraphCache[S, T]) weaker(v, w *weight) bool {
+	if c.limits.KeepBy == RankRecency {
+		return v.seq < w.seq
+	}
+	return c.edges.value(v) < c.edges.value(w)
+}
+
+// capOutDegree deletes the weakest edges from tail beyond MaxOutDegree, and returns them.
+func (c *GraphCache[S, T]) capOutDegree(tail S) []Edge[S] {
+	var evicted []Edge[S]
+	heads := c.edges.tf[tail]
+	for c.limits.MaxOutDegree > 0 && len(heads) > c.limits.MaxOutDegree {
+		var weakest S
+		var min *weight
+		for head, w := range heads {
+			if min == nil || c.weaker(w, min) {
+				weakest, min = head, w
+			}
+		}
+		evicted = append(evicted, c.edges.deleteEdge(tail, weakest)...)
+	}
+	return evicted
+}
@@ -0,0 +1,224 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Policy
+		wantErr error
+	}{
+		{s: "", want: Reject},
+		{s: "reject", want: Reject},
+		{s: "lru", want: LRU},
+		{s: "lfu", want: LFU},
+		{s: "soonest-expiring", want: SoonestExpiring},
+		{s: "lowest-weight", want: LowestWeight},
+		{s: "random", wantErr: ErrUnknownPolicy},
+	}
+	for _, tt := range tests {
+		got, err := ParsePolicy(tt.s)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("ParsePolicy(%q) = %v, %v, want %v, %v", tt.s, got, err, tt.want, tt.wantErr)
+		}
+		if err == nil && tt.s != "" && got.String() != tt.s {
+			t.Errorf("%v.String() = %q, want %q", got, got.String(), tt.s)
+		}
+	}
+}
+
+func TestParseRank(t *testing.T) {
+	for s, want := range map[string]Rank{"": RankWeight, "weight": RankWeight, "recency": RankRecency} {
+		if got, err := ParseRank(s); err != nil || got != want {
+			t.Errorf("ParseRank(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+	if _, err := ParseRank("age"); !errors.Is(err, ErrUnknownRank) {
+		t.Errorf("ParseRank(age) error = %v, want %v", err, ErrUnknownRank)
+	}
+}
+
+func keys(vertices []Vertex[string, int]) []string {
+	var k []string
+	for _, v := range vertices {
+		k = append(k, v.Key)
+	}
+	sort.Strings(k)
+	return k
+}
+
+func TestGraphCache_evictVertices(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		policy Policy
+		read   []string
+		want   []string
+	}{
+		{name: "LRU", policy: LRU, read: []string{"a", "b"}, want: []string{"c"}},
+		// d is evicted as soon as it is added, since it is read only once.
+		{name: "LFU", policy: LFU, read: []string{"a", "a", "b", "b", "c", "c"}, want: []string{"d"}},
+		{name: "soonest expiring", policy: SoonestExpiring, want: []string{"a"}},
+		{name: "lowest weight", policy: LowestWeight, want: []string{"b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewGraphCache[string, int](time.Minute)
+			c.SetLimits(Limits[string, int]{MaxVertices: 3, Policy: tt.policy})
+			var evicted []Vertex[string, int]
+			c.OnEvict(func(vertices []Vertex[string, int], edges []Edge[string]) {
+				evicted = append(evicted, vertices...)
+			})
+
+			c.AddVertexWithExpiration("a", 1, now.Add(time.Minute))
+			c.AddVertexWithExpiration("b", 2, now.Add(2*time.Hour))
+			c.AddVertexWithExpiration("c", 3, now.Add(3*time.Hour))
+			c.AddEdgeWithExpiration("a", "c", 5, now.Add(time.Hour))
+			for _, key := range tt.read {
+				c.GetVertex(key)
+			}
+			c.AddEdgeWithExpiration("c", "d", 1, now.Add(4*time.Hour))
+
+			if got := keys(evicted); len(got) != len(tt.want) || got[0] != tt.want[0] {
+				t.Errorf("evicted = %v, want %v", got, tt.want)
+			}
+			if vertices, _ := c.Size(); vertices != 3 {
+				t.Errorf("Size() = %d vertices, want 3", vertices)
+			}
+		})
+	}
+}
+
+func TestGraphCache_evictEdges(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	c.SetLimits(Limits[string, int]{MaxEdges: 2, Policy: LowestWeight})
+	var evicted []Edge[string]
+	c.OnEvict(func(vertices []Vertex[string, int], edges []Edge[string]) {
+		if len(vertices) > 0 {
+			t.Errorf("evicted vertices = %v, want none", vertices)
+		}
+		evicted = append(evicted, edges...)
+	})
+
+	c.AddEdge("a", "b", 3)
+	c.AddEdge("a", "c", 1)
+	c.AddEdge("b", "c", 2)
+
+	if len(evicted) != 1 || evicted[0].Tail != "a" || evicted[0].Head != "c" {
+		t.Errorf("evicted = %v, want [a->c]", evicted)
+	}
+	if _, edges := c.Size(); edges != 2 {
+		t.Errorf("Size() = %d edges, want 2", edges)
+	}
+}
+
+func TestGraphCache_evictBytes(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	c.PutVertex("a", 1)
+	limit := c.memory() * 10
+	c.SetLimits(Limits[string, int]{
+		MaxBytes:  limit,
+		Policy:    LRU,
+		KeySize:   func(key string) int { return len(key) },
+		ValueSize: func(value int) int { return value },
+	})
+
+	for i := 0; i < 100; i++ {
+		c.PutVertex(string(rune('a'+i%26))+string(rune('a'+i/26)), 8)
+	}
+	if c.memory() > limit {
+		t.Errorf("memory() = %d, want <= %d", c.memory(), limit)
+	}
+	if _, ok := c.GetVertex("a"); ok {
+		t.Errorf("GetVertex(a) is not evicted")
+	}
+}
+
+func TestGraphCache_Fits(t *testing.T) {
+	c := NewGraphCache[string, int](time.Minute)
+	c.SetLimits(Limits[string, int]{MaxVertices: 3, MaxEdges: 1})
+	c.PutVertex("a", 1)
+	c.AddEdge("a", "b", 1)
+
+	tests := []struct {
+		name     string
+		vertices []Vertex[string, int]
+		edges    []Edge[string]
+		wantErr  error
+	}{
+		{name: "existing vertices", vertices: []Vertex[string, int]{{Key: "a"}, {Key: "b"}}},
+		{name: "new vertex", vertices: []Vertex[string, int]{{Key: "c"}, {Key: "c"}}},
+		{name: "too many vertices", vertices: []Vertex[string, int]{{Key: "c"}, {Key: "d"}}, wantErr: ErrLimitExceeded},
+		{name: "existing edge", edges: []Edge[string]{{Tail: "a", Head: "b"}}},
+		{name: "too many edges", edges: []Edge[string]{{Tail: "b", Head: "a"}}, wantErr: ErrLimitExceeded},
+		{name: "vertices of edges", edges: []Edge[string]{{Tail: "c", Head: "d"}}, wantErr: ErrLimitExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := c.Fits(tt.vertices, tt.edges); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Fits() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The cache is left as it is, even if it exceeds its limits.
+	c.AddEdge("b", "a", 1)
+	if _, edges := c.Size(); edges != 2 {
+		t.Errorf("Size() = %d edges, want 2", edges)
+	}
+}
+
+func TestGraphCache_capOutDegree(t *testing.T) {
+	tests := []struct {
+		name        string
+		rank        Rank
+		want        map[string]float32
+		wantEvicted int
+	}{
+		// e is evicted as soon as it is added, since it is the lightest.
+		{name: "weight", rank: RankWeight, want: map[string]float32{"b": 4, "d": 3}, wantEvicted: 2},
+		// b is evicted before it is added again.
+		{name: "recency", rank: RankRecency, want: map[string]float32{"b": 2, "e": 1}, wantEvicted: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewGraphCache[string, int](time.Minute)
+			c.AddEdge("a", "b", 2)
+			c.AddEdge("a", "c", 1)
+			c.AddEdge("x", "c", 1)
+			c.SetLimits(Limits[string, int]{MaxOutDegree: 2, KeepBy: tt.rank})
+			var evicted []Edge[string]
+			c.OnEvict(func(vertices []Vertex[string, int], edges []Edge[string]) {
+				evicted = append(evicted, edges...)
+			})
+
+			c.AddEdge("a", "d", 3)
+			c.AddEdge("a", "b", 2)
+			c.AddEdge("a", "e", 1)
+
+			if got := c.OutEdges("a"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OutEdges(a) = %v, want %v", got, tt.want)
+			}
+			if len(evicted) != tt.wantEvicted {
+				t.Errorf("evicted = %v, want %d edges", evicted, tt.wantEvicted)
+			}
+			if got := c.OutEdges("x"); len(got) != 1 {
+				t.Errorf("OutEdges(x) = %v, want an edge", got)
+			}
+		})
+	}
+
+	c := NewGraphCache[string, int](time.Minute)
+	c.AddEdge("a", "b", 1)
+	c.AddEdge("a", "c", 2)
+	c.SetLimits(Limits[string, int]{MaxOutDegree: 1})
+	if got := c.OutEdges("a"); len(got) != 1 || got["c"] != 2 {
+		t.Errorf("OutEdges(a) after SetLimits() = %v, want [c]", got)
+	}
+}
@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"time"
+)
+
+// SetSlidingTTL makes reads of vertices and edges extend their expirations to slidingTTL after
+// the reads, unless they are added with their own sliding TTLs. It applies to vertices and
+// edges added after it is set, and 0 disables the extension.
+func (c *GraphCache[S, T]) SetSlidingTTL(slidingTTL time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.slidingTTL = slidingTTL
+}
+
+// SlidingTTL returns the sliding TTL set by SetSlidingTTL.
+func (c *GraphCache[S, T]) SlidingTTL() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.slidingTTL
+}
+
+// sliding returns slidingTTL, or the sliding TTL of the cache if it is 0.
+func (c *GraphCache[S, T]) sliding(slidingTTL time.Duration) time.Duration {
+	if slidingTTL == 0 {
+		return c.slidingTTL
+	}
+	return slidingTTL
+}
+
+// read records a read of a vertex or an edge which has not expired, which extends its
+// expiration if it slides.
+func (c *GraphCache[S, T]) read(u *usage) {
+	if u == nil {
+		return
+	}
+	if u.sliding > 0 {
+		now := time.Now().UnixNano()
+		if last := u.read.Load(); last == 0 || now > last+int64(u.sliding) {
+			u.since.Store(now)
+		}
+		u.read.Store(now)
+	}
+	c.touch(u)
+}
+
+// expiresAt returns expiration extended to the sliding TTL after the last read. Reads extend
+// only expirations which had not passed when they began to follow each other, so that a read
+// never brings back a value of an edge which has expired.
+func (u *usage) expiresAt(expiration time.Time) time.Time {
+	if u == nil || u.sliding <= 0 {
+		return expiration
+	}
+	read := u.read.Load()
+	if read == 0 || u.since.Load() > expiration.UnixNano() {
+		return expiration
+	}
+	if at := time.Unix(0, read).Add(u.sliding); at.After(expiration) {
+		return at
+	}
+	return expiration
+}
+
+// TouchVertex extends the expiration of a vertex which has not expired yet to expiration,
+// without changing its value, and reports whether the vertex exists. An expiration which is
+// earlier than the current one is ignored.
+func (c *GraphCache[S, T]) TouchVertex(key S, expiration time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.vertices[key]
+	if !ok || v.expired(time.Now()) {
+		return false
+	}
+	if expiration.After(v.expiresAt()) {
+		v.expiration = expiration
+		c.vertices[key] = v
+		c.expiries.push(expiry[S]{at: expiration.UnixNano(), kind: expiryVertex, tail: key})
+	}
+	return true
+}
+
+// TouchEdge extends expirations of the weights of an edge which have not expired yet to
+// expiration, without changing them, and reports whether the edge exists. Expirations which
+// are later than expiration are kept.
+func (c *GraphCache[S, T]) TouchEdge(tail, head S, expiration time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w, ok := c.edges.tf[tail][head]
+	if !ok {
+		return false
+	}
+	now := time.Now()
+	touched := false
+	for i, v := range w.values {
+		if w.expired(v, now) {
+			continue
+		}
+		touched = true
+		if expiration.After(w.expiresAt(v)) {
+			w.values[i].expiration = expiration
+		}
+	}
+	if touched {
+		c.expiries.push(expiry[S]{at: expiration.UnixNano(), kind: expiryEdge, tail: tail, head: head})
+	}
+	return touched
+}
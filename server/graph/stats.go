@@ -0,0 +1,161 @@
+package graph
+
+import (
+	"github.com/anaregdesign/papaya/collection/pq"
+	"math"
+	"sort"
+	"time"
+	"unsafe"
+)
+
+var (
+	// DegreeBounds are upper bounds of buckets of DegreeHistogram.
+	DegreeBounds = []float64{0, 1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024, math.Inf(1)}
+	// TTLBounds are upper bounds of buckets of TTLHistogram in seconds.
+	TTLBounds = []float64{1, 10, 60, 600, 3600, 86400, math.Inf(1)}
+)
+
+// mapEntryOverhead is an approximate number of bytes a Go map spends on an entry besides
+// its key and value.
+const mapEntryOverhead = 16
+
+// Bucket counts values which are greater than the upper bound of the previous bucket,
+// and less than or equal to UpperBound.
+type Bucket struct {
+	UpperBound float64
+	Count      int
+}
+
+// Degree is the number of edges from and to a vertex.
+type Degree[S comparable] struct {
+	Key S
+	Out int
+	In  int
+}
+
+// Stats is a point-in-time summary of GraphCache.
+type Stats[S comparable] struct {
+	Vertices    int
+	NilVertices int
+	Edges       int
+	// DegreeHistogram counts vertices by the sum of their out and in degrees.
+	DegreeHistogram []Bucket
+	// TopDegrees are vertices with the highest sums of out and in degrees, in descending order.
+	TopDegrees []Degree[S]
+	// TTLHistogram counts vertices and weights of edges by seconds until they expire.
+	TTLHistogram []Bucket
+	// MemoryBytes is an approximate number of bytes used by vertices, edges and deadlines.
+	MemoryBytes int64
+}
+
+// StatsOptions tells GraphCache.Stats about keys and values, which it cannot inspect.
+// KeySize and ValueSize return bytes referenced by a key or a value besides its own size,
+// e.g. the bytes of a string. Owns selects vertices which are counted, e.g. the ones owned by a
+// node of a cluster; other vertices only add their degrees to TopDegrees, so that degrees can be
+// summed over nodes. Any of the functions may be nil.
+type StatsOptions[S comparable, T any] struct {
+	Top       int
+	IsNil     func(value T) bool
+	KeySize   func(key S) int
+	ValueSize func(value T) int
+	Owns      func(key S) bool
+}
+
+func newHistogram(bounds []float64) []Bucket {
+	h := make([]Bucket, len(bounds))
+	for i, b := range bounds {
+		h[i].UpperBound = b
+	}
+	return h
+}
+
+func observe(h []Bucket, value float64) {
+	i := sort.Search(len(h), func(i int) bool { return value <= h[i].UpperBound })
+	if i < len(h) {
+		h[i].Count++
+	}
+}
+
+// Stats returns a summary of vertices and edges which have not expired yet.
+func (c *GraphCache[S, T]) Stats(options StatsOptions[S, T]) Stats[S] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keySize := func(key S) int64 {
+		size := int64(unsafe.Sizeof(key)) + mapEntryOverhead
+		if options.KeySize != nil {
+			size += int64(options.KeySize(key))
+		}
+		return size
+	}
+
+	now := time.Now()
+	stats := Stats[S]{
+		DegreeHistogram: newHistogram(DegreeBounds),
+		TTLHistogram:    newHistogram(TTLBounds),
+	}
+	degrees := make(map[S]*Degree[S], len(c.vertices))
+	for key, v := range c.vertices {
+		stats.MemoryBytes += keySize(key) + int64(unsafe.Sizeof(v))
+		if options.ValueSize != nil {
+			stats.MemoryBytes += int64(options.ValueSize(v.value))
+		}
+		if v.expired(now) {
+			continue
+		}
+		degrees[key] = &Degree[S]{Key: key}
+		if options.Owns != nil && !options.Owns(key) {
+			continue
+		}
+		stats.Vertices++
+		if options.IsNil != nil && options.IsNil(v.value) {
+			stats.NilVertices++
+		}
+		observe(stats.TTLHistogram, v.expiresAt().Sub(now).Seconds())
+	}
+
+	for tail, heads := range c.edges.tf {
+		stats.MemoryBytes += keySize(tail)
+		for head, w := range heads {
+			stats.MemoryBytes += keySize(head) + keySize(tail) + int64(unsafe.Sizeof(*w)) + int64(cap(w.values))*int64(unsafe.Sizeof(weightValue{}))
+
+			live := false
+			for _, v := range w.values {
+				if !w.expired(v, now) {
+					live = true
+					observe(stats.TTLHistogram, w.expiresAt(v).Sub(now).Seconds())
+				}
+			}
+			if !live {
+				continue
+			}
+			stats.Edges++
+			if d, ok := degrees[tail]; ok {
+				d.Out++
+			}
+			if d, ok := degrees[head]; ok {
+				d.In++
+			}
+		}
+	}
+	for head := range c.edges.in {
+		stats.MemoryBytes += keySize(head)
+	}
+	stats.MemoryBytes += int64(cap(c.expiries.heap)) * int64(unsafe.Sizeof(expiry[S]{}))
+	stats.MemoryBytes += int64(len(c.expiries.scheduled)) * int64(unsafe.Sizeof(expiry[S]{})+8)
+
+	totals := pq.SortableMap[S, int]{}
+	for key, d := range degrees {
+		if options.Owns == nil || options.Owns(key) {
+			observe(stats.DegreeHistogram, float64(d.Out+d.In))
+		}
+		totals[key] = d.Out + d.In
+	}
+	for key := range totals.Top(options.Top) {
+		stats.TopDegrees = append(stats.TopDegrees, *degrees[key])
+	}
+	sort.Slice(stats.TopDegrees, func(i, j int) bool {
+		return totals[stats.TopDegrees[i].Key] > totals[stats.TopDegrees[j].Key]
+	})
+	return stats
+}
@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGraphCache_Stats(t *testing.T) {
+	c := NewGraphCache[string, *int](time.Minute)
+	now := time.Now()
+	one := 1
+	c.AddVertexWithExpiration("a", &one, now.Add(time.Hour))
+	c.AddVertexWithExpiration("expired", &one, now.Add(-time.Second))
+	c.AddEdgeWithExpiration("a", "b", 1, now.Add(5*time.Second))
+	c.AddEdgeWithExpiration("a", "c", 1, now.Add(5*time.Second))
+	c.AddEdgeWithExpiration("b", "c", 1, now.Add(5*time.Second))
+	c.AddEdgeWithExpiration("c", "a", 1, now.Add(-time.Second))
+
+	stats := c.Stats(StatsOptions[string, *int]{
+		Top:   2,
+		IsNil: func(v *int) bool { return v == nil },
+	})
+	if stats.Vertices != 3 || stats.NilVertices != 2 || stats.Edges != 3 {
+		t.Errorf("Stats() = %d vertices, %d nil vertices and %d edges, want 3, 2 and 3",
+			stats.Vertices, stats.NilVertices, stats.Edges)
+	}
+
+	// Every vertex has degree 2.
+	if d := stats.TopDegrees; len(d) != 2 || d[0].Out+d[0].In != 2 || d[1].Out+d[1].In != 2 {
+		t.Errorf("TopDegrees = %v, want 2 vertices of degree 2", d)
+	}
+
+	degrees := make(map[float64]int)
+	for _, b := range stats.DegreeHistogram {
+		if b.Count > 0 {
+			degrees[b.UpperBound] = b.Count
+		}
+	}
+	if want := map[float64]int{2: 3}; !reflect.DeepEqual(degrees, want) {
+		t.Errorf("DegreeHistogram = %v, want %v", degrees, want)
+	}
+
+	// b and c expire with the edges in 5 seconds, and a in an hour.
+	ttls := make(map[float64]int)
+	for _, b := range stats.TTLHistogram {
+		if b.Count > 0 {
+			ttls[b.UpperBound] = b.Count
+		}
+	}
+	if want := map[float64]int{10: 5, 3600: 1}; !reflect.DeepEqual(ttls, want) {
+		t.Errorf("TTLHistogram = %v, want %v", ttls, want)
+	}
+
+	if stats.MemoryBytes <= 0 {
+		t.Errorf("MemoryBytes = %d, want positive", stats.MemoryBytes)
+	}
+}
+
+func TestGraphCache_Stats_Owns(t *testing.T) {
+	c := NewGraphCache[string, *int](time.Minute)
+	c.AddEdge("a", "b", 1)
+	c.AddEdge("a", "c", 1)
+
+	// b and c are placeholders of vertices owned by another node.
+	stats := c.Stats(StatsOptions[string, *int]{
+		Top:  3,
+		Owns: func(key string) bool { return key == "a" },
+	})
+	if stats.Vertices != 1 || stats.Edges != 2 {
+		t.Errorf("Stats() = %d vertices and %d edges, want 1 and 2", stats.Vertices, stats.Edges)
+	}
+	var counted int
+	for _, b := range stats.DegreeHistogram {
+		counted += b.Count
+	}
+	if counted != 1 {
+		t.Errorf("DegreeHistogram counts %d vertices, want 1", counted)
+	}
+	if len(stats.TopDegrees) != 3 {
+		t.Errorf("TopDegrees = %v, want degrees of all 3 vertices", stats.TopDegrees)
+	}
+}
@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"strings"
+)
+
+// redacted replaces values of environment variables which may hold secrets.
+const redacted = "[REDACTED]"
+
+// loggedPrefixes are prefixes of environment variables which configure lantern-server.
+var loggedPrefixes = []string{"LANTERN_", "OTEL_"}
+
+var secretWords = []string{"SECRET", "TOKEN", "PASSWORD", "PASSWD", "CREDENTIAL", "KEY", "HEADERS"}
+
+// Environ returns environment variables of environ in the form "key=value" which configure
+// lantern-server, with values of ones whose names look secret redacted.
+func Environ(environ []string) []string {
+	var env []string
+	for _, pair := range environ {
+		key, value, _ := strings.Cut(pair, "=")
+		if !hasAnyPrefix(key, loggedPrefixes) {
+			continue
+		}
+		if secret(key) {
+			value = redacted
+		}
+		env = append(env, key+"="+value)
+	}
+	return env
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func secret(key string) bool {
+	key = strings.ToUpper(key)
+	for _, word := range secretWords {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	mrand "math/rand"
+	"time"
+)
+
+// RequestIDKey is a metadata key of the ID of a request. An ID sent by a client is kept,
+// otherwise a new one is generated. The ID is sent back in the response header.
+const RequestIDKey = "x-request-id"
+
+// Interceptor logs a line for each RPC with its request ID. Lines of successful RPCs and
+// errors of clients are sampled at sampleRate, while server errors are always logged.
+// Requests themselves are logged at debug level.
+type Interceptor struct {
+	logger     *slog.Logger
+	sampleRate float64
+}
+
+func NewInterceptor(logger *slog.Logger, sampleRate float64) *Interceptor {
+	return &Interceptor{
+		logger:     logger,
+		sampleRate: sampleRate,
+	}
+}
+
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDKey); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// start returns a context carrying a logger with the ID of a request.
+func (i *Interceptor) start(ctx context.Context) (context.Context, *slog.Logger) {
+	id := requestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id))
+	logger := i.logger.With("request_id", id)
+	return NewContext(ctx, logger), logger
+}
+
+func (i *Interceptor) finish(ctx context.Context, logger *slog.Logger, method string, start time.Time, err error) {
+	code := status.Code(err)
+	attrs := []any{"method", method, "code", code.String(), "duration", time.Since(start)}
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.DeadlineExceeded:
+		logger.ErrorCtx(ctx, "RPC failed", append(attrs, "error", err)...)
+	default:
+		if i.sampleRate >= 1 || mrand.Float64() < i.sampleRate {
+			logger.InfoCtx(ctx, "RPC", attrs...)
+		}
+	}
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		ctx, logger := i.start(ctx)
+		logger.DebugCtx(ctx, "Request", "method", info.FullMethod, "request", req)
+		resp, err := handler(ctx, req)
+		i.finish(ctx, logger, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx, logger := i.start(ss.Context())
+		logger.DebugCtx(ctx, "Stream", "method", info.FullMethod)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		i.finish(ctx, logger, info.FullMethod, start, err)
+		return err
+	}
+}
@@ -0,0 +1,125 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestNewLogger(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   string
+		format  string
+		want    string
+		wantErr error
+	}{
+		{name: "default", want: `level=INFO msg=info`},
+		{name: "json", level: "debug", format: "json", want: `"level":"DEBUG","msg":"debug"`},
+		{name: "warn", level: "WARN", want: `level=WARN msg=warn`},
+		{name: "unknown level", level: "verbose", wantErr: ErrUnknownLevel},
+		{name: "unknown format", format: "xml", wantErr: ErrUnknownFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			logger, err := NewLogger(&b, tt.level, tt.format)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewLogger() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			logger.Debug("debug")
+			logger.Info("info")
+			logger.Warn("warn")
+			if lines := strings.Split(b.String(), "\n"); !strings.Contains(lines[0], tt.want) {
+				t.Errorf("first line = %s, want %s", lines[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestEnviron(t *testing.T) {
+	got := Environ([]string{
+		"HOME=/root",
+		"LANTERN_PORT=6380",
+		"LANTERN_AUTH_TOKEN=secret",
+		"OTEL_EXPORTER_OTLP_HEADERS=authorization=secret",
+		"AWS_SECRET_ACCESS_KEY=secret",
+	})
+	want := []string{
+		"LANTERN_PORT=6380",
+		"LANTERN_AUTH_TOKEN=" + redacted,
+		"OTEL_EXPORTER_OTLP_HEADERS=" + redacted,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Environ() = %v, want %v", got, want)
+	}
+}
+
+func TestInterceptor_Unary(t *testing.T) {
+	tests := []struct {
+		name       string
+		sampleRate float64
+		err        error
+		wantLog    bool
+	}{
+		{name: "logged", sampleRate: 1, wantLog: true},
+		{name: "sampled out", sampleRate: 0},
+		{name: "client error sampled out", sampleRate: 0, err: status.Error(codes.NotFound, "vertex not found")},
+		{name: "server error", sampleRate: 0, err: status.Error(codes.Internal, "broken"), wantLog: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			logger, _ := NewLogger(&b, "info", "text")
+			interceptor := NewInterceptor(logger, tt.sampleRate).Unary()
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDKey, "abc"))
+			_, _ = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/graph.v1.LanternService/GetVertex"}, func(ctx context.Context, req any) (any, error) {
+				return nil, tt.err
+			})
+
+			line := b.String()
+			if got := line != ""; got != tt.wantLog {
+				t.Fatalf("logged = %v, want %v: %s", got, tt.wantLog, line)
+			}
+			if tt.wantLog && !strings.Contains(line, "request_id=abc") {
+				t.Errorf("log = %s, want request_id=abc", line)
+			}
+		})
+	}
+}
+
+func TestInterceptor_RequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want *regexp.Regexp
+	}{
+		{name: "sent by client", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDKey, "abc")), want: regexp.MustCompile(`request_id=abc$`)},
+		{name: "generated", ctx: context.Background(), want: regexp.MustCompile(`request_id=[0-9a-f]{16}$`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			logger, _ := NewLogger(&b, "info", "text")
+			interceptor := NewInterceptor(logger, 0).Unary()
+			_, _ = interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/graph.v1.LanternService/GetVertex"}, func(ctx context.Context, req any) (any, error) {
+				FromContext(ctx).Info("handled")
+				return nil, nil
+			})
+			if !tt.want.MatchString(strings.TrimSpace(b.String())) {
+				t.Errorf("log = %s, want %v", b.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"context"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+const namespace = "lantern"
+
+// Metrics collects metrics of RPCs, the graph, its evictions and its sweeper in its own registry.
+type Metrics struct {
+	registry      *prometheus.Registry
+	requests      *prometheus.CounterVec
+	latency       *prometheus.HistogramVec
+	sweepDuration prometheus.Gauge
+	evictions     *prometheus.CounterVec
+}
+
+func NewMetrics(cache *graph.GraphCache[string, *v1.Vertex], sweeper *graph.Sweeper[string, *v1.Vertex]) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_total",
+			Help:      "Number of RPCs handled, by method and status code.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Latency of RPCs, by method. Streams are measured until they end.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		sweepDuration: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "sweep_duration_seconds",
+			Help:      "Duration of the last sweep of expired vertices and edges.",
+		}),
+		evictions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "evictions_total",
+			Help:      "Number of vertices and edges evicted to keep the graph within its limits, by kind.",
+		}, []string{"kind"}),
+	}
+
+	m.registry.MustRegister(
+		m.requests,
+		m.latency,
+		m.sweepDuration,
+		m.evictions,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "vertices",
+			Help:      "Number of vertices stored, including expired ones not swept yet.",
+		}, func() float64 {
+			vertices, _ := cache.Size()
+			return float64(vertices)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "edges",
+			Help:      "Number of edges stored, including expired ones not swept yet.",
+		}, func() float64 {
+			_, edges := cache.Size()
+			return float64(edges)
+		}),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	sweeper.OnSweep(func(d time.Duration) {
+		m.sweepDuration.Set(d.Seconds())
+	})
+	cache.OnEvict(func(vertices []graph.Vertex[string, *v1.Vertex], edges []graph.Edge[string]) {
+		m.evictions.WithLabelValues("vertex").Add(float64(len(vertices)))
+		m.evictions.WithLabelValues("edge").Add(float64(len(edges)))
+	})
+	return m
+}
+
+// Handler serves metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) observe(method string, start time.Time, err error) {
+	m.requests.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.latency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observe(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observe(info.FullMethod, start, err)
+		return err
+	}
+}
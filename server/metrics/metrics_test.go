@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"context"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	cache := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	cache.AddEdge("a", "b", 1)
+	cache.SetLimits(graph.Limits[string, *v1.Vertex]{MaxEdges: 1, Policy: graph.LowestWeight})
+	m := NewMetrics(cache, graph.NewSweeper(cache, time.Second))
+	cache.AddEdge("b", "c", 2)
+
+	interceptor := m.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/graph.v1.LanternService/GetVertex"}
+	for _, err := range []error{nil, nil, status.Error(codes.NotFound, "vertex not found: a")} {
+		_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			return nil, err
+		})
+	}
+
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(recorder.Body)
+	for _, want := range []string{
+		`lantern_grpc_requests_total{code="OK",method="/graph.v1.LanternService/GetVertex"} 2`,
+		`lantern_grpc_requests_total{code="NotFound",method="/graph.v1.LanternService/GetVertex"} 1`,
+		`lantern_grpc_request_duration_seconds_count{method="/graph.v1.LanternService/GetVertex"} 3`,
+		`lantern_vertices 3`,
+		`lantern_edges 1`,
+		`lantern_evictions_total{kind="edge"} 1`,
+		`lantern_sweep_duration_seconds 0`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("metrics do not contain %q", want)
+		}
+	}
+}
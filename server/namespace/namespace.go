@@ -0,0 +1,59 @@
+package namespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"regexp"
+)
+
+// MetadataKey is a metadata key of the namespace of a request.
+const MetadataKey = "lantern-namespace"
+
+// Default is the namespace of requests without MetadataKey. It is always present, and it
+// cannot be flushed or dropped.
+const Default = "default"
+
+// ErrorDomain and NotFoundReason are the domain and reason of the ErrorInfo detail of
+// codes.NotFound for a missing namespace, which tell it from a missing vertex or edge.
+const (
+	ErrorDomain    = "lantern"
+	NotFoundReason = "NAMESPACE_NOT_FOUND"
+)
+
+var ErrInvalidName = errors.New("invalid namespace name")
+
+var pattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+// Validate returns ErrInvalidName unless name consists of up to 64 letters, digits, '_', '.'
+// and '-', starting with a letter or a digit.
+func Validate(name string) error {
+	if !pattern.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	return nil
+}
+
+// FromIncomingContext returns the namespace of a request, or Default if it is not set.
+func FromIncomingContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if names := md.Get(MetadataKey); len(names) > 0 && names[0] != "" {
+			return names[0]
+		}
+	}
+	return Default
+}
+
+// NotFound returns an error of codes.NotFound with message for a namespace which does not
+// exist, or has been dropped.
+func NotFound(message string) error {
+	s := status.New(codes.NotFound, message)
+	if d, err := s.WithDetails(&errdetails.ErrorInfo{Reason: NotFoundReason, Domain: ErrorDomain}); err == nil {
+		s = d
+	}
+	return s.Err()
+}
@@ -1,21 +1,74 @@
 package provider
 
 import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
 	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
-	"github.com/anaregdesign/papaya/cache/graph"
+	"github.com/anaregdesign/lantern/server/auth"
+	"github.com/anaregdesign/lantern/server/certs"
+	"github.com/anaregdesign/lantern/server/cluster"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/logging"
+	"github.com/anaregdesign/lantern/server/metrics"
+	"github.com/anaregdesign/lantern/server/replication"
+	"github.com/anaregdesign/lantern/server/service"
+	"github.com/anaregdesign/lantern/server/sink"
+	"github.com/anaregdesign/lantern/server/storage"
+	"github.com/anaregdesign/lantern/server/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"golang.org/x/exp/slog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"net"
+	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	ttl  time.Duration
-	port int
+	ttl              time.Duration
+	port             int
+	sweepInterval    time.Duration
+	snapshotPath     string
+	snapshotInterval time.Duration
+	walDir           string
+	walSyncPolicy    storage.SyncPolicy
+	leader           string
+	node             string
+	nodes            []string
+	expirySink       string
+	metricsPort      int
+	traceExporter    string
+	logLevel         string
+	logFormat        string
+	logSampleRate    float64
+	tlsCertFile      string
+	tlsKeyFile       string
+	tlsClientCAFile  string
+	tlsCAFile        string
+	tlsReload        time.Duration
+	authTokensFile   string
+	authJWTKeyFile   string
+	authPeerToken    string
+	maxVertices      int
+	maxEdges         int
+	maxBytes         int64
+	evictionPolicy   graph.Policy
+	maxOutDegree     int
+	outDegreeRank    graph.Rank
+	decayHalfLife    time.Duration
+	slidingTTL       time.Duration
 }
 
-func NewConfig() *Config {
+// NewConfig reads the configuration from environment variables. Invalid numbers fall back to
+// their defaults, while an unknown eviction policy, WAL sync policy or out-degree rank is an error.
+func NewConfig() (*Config, error) {
 	ttl, err := strconv.Atoi(os.Getenv("LANTERN_DEFAULT_TTL_SECONDS"))
 	if err != nil {
 		ttl = 60
@@ -26,22 +79,315 @@ func NewConfig() *Config {
 		port = 6380
 	}
 
+	sweepInterval, err := strconv.Atoi(os.Getenv("LANTERN_SWEEP_INTERVAL_SECONDS"))
+	if err != nil || sweepInterval <= 0 {
+		sweepInterval = 1
+	}
+
+	snapshotInterval, err := strconv.Atoi(os.Getenv("LANTERN_SNAPSHOT_INTERVAL_SECONDS"))
+	if err != nil || snapshotInterval <= 0 {
+		snapshotInterval = 300
+	}
+
+	walSyncPolicy, err := storage.ParseSyncPolicy(os.Getenv("LANTERN_WAL_SYNC"))
+	if err != nil {
+		return nil, fmt.Errorf("LANTERN_WAL_SYNC: %w", err)
+	}
+
+	metricsPort, err := strconv.Atoi(os.Getenv("LANTERN_METRICS_PORT"))
+	if err != nil {
+		metricsPort = 9090
+	}
+
+	logSampleRate, err := strconv.ParseFloat(os.Getenv("LANTERN_LOG_SAMPLE_RATE"), 64)
+	if err != nil {
+		logSampleRate = 1
+	}
+
+	tlsReload, err := strconv.Atoi(os.Getenv("LANTERN_TLS_RELOAD_INTERVAL_SECONDS"))
+	if err != nil || tlsReload <= 0 {
+		tlsReload = 60
+	}
+
+	maxVertices, err := strconv.Atoi(os.Getenv("LANTERN_MAX_VERTICES"))
+	if err != nil {
+		maxVertices = 0
+	}
+
+	maxEdges, err := strconv.Atoi(os.Getenv("LANTERN_MAX_EDGES"))
+	if err != nil {
+		maxEdges = 0
+	}
+
+	maxBytes, err := strconv.ParseInt(os.Getenv("LANTERN_MAX_BYTES"), 10, 64)
+	if err != nil {
+		maxBytes = 0
+	}
+
+	evictionPolicy, err := graph.ParsePolicy(os.Getenv("LANTERN_EVICTION_POLICY"))
+	if err != nil {
+		return nil, fmt.Errorf("LANTERN_EVICTION_POLICY: %w", err)
+	}
+
+	maxOutDegree, err := strconv.Atoi(os.Getenv("LANTERN_MAX_OUT_DEGREE"))
+	if err != nil {
+		maxOutDegree = 0
+	}
+
+	outDegreeRank, err := graph.ParseRank(os.Getenv("LANTERN_OUT_DEGREE_RANK"))
+	if err != nil {
+		return nil, fmt.Errorf("LANTERN_OUT_DEGREE_RANK: %w", err)
+	}
+
+	decayHalfLife, err := strconv.Atoi(os.Getenv("LANTERN_DECAY_HALF_LIFE_SECONDS"))
+	if err != nil {
+		decayHalfLife = 0
+	}
+
+	slidingTTL, err := strconv.Atoi(os.Getenv("LANTERN_SLIDING_TTL_SECONDS"))
+	if err != nil {
+		slidingTTL = 0
+	}
+
+	var nodes []string
+	for _, node := range strings.Split(os.Getenv("LANTERN_CLUSTER_NODES"), ",") {
+		if node = strings.TrimSpace(node); node != "" {
+			nodes = append(nodes, node)
+		}
+	}
+
 	return &Config{
-		ttl:  time.Duration(ttl) * time.Second,
-		port: port,
+		ttl:              time.Duration(ttl) * time.Second,
+		port:             port,
+		sweepInterval:    time.Duration(sweepInterval) * time.Second,
+		snapshotPath:     os.Getenv("LANTERN_SNAPSHOT_PATH"),
+		snapshotInterval: time.Duration(snapshotInterval) * time.Second,
+		walDir:           os.Getenv("LANTERN_WAL_DIR"),
+		walSyncPolicy:    walSyncPolicy,
+		leader:           os.Getenv("LANTERN_REPLICATION_LEADER"),
+		node:             os.Getenv("LANTERN_CLUSTER_NODE"),
+		nodes:            nodes,
+		expirySink:       os.Getenv("LANTERN_EXPIRY_SINK"),
+		metricsPort:      metricsPort,
+		traceExporter:    os.Getenv("LANTERN_TRACE_EXPORTER"),
+		logLevel:         os.Getenv("LANTERN_LOG_LEVEL"),
+		logFormat:        os.Getenv("LANTERN_LOG_FORMAT"),
+		logSampleRate:    logSampleRate,
+		tlsCertFile:      os.Getenv("LANTERN_TLS_CERT_FILE"),
+		tlsKeyFile:       os.Getenv("LANTERN_TLS_KEY_FILE"),
+		tlsClientCAFile:  os.Getenv("LANTERN_TLS_CLIENT_CA_FILE"),
+		tlsCAFile:        os.Getenv("LANTERN_TLS_CA_FILE"),
+		tlsReload:        time.Duration(tlsReload) * time.Second,
+		authTokensFile:   os.Getenv("LANTERN_AUTH_TOKENS_FILE"),
+		authJWTKeyFile:   os.Getenv("LANTERN_AUTH_JWT_KEY_FILE"),
+		authPeerToken:    os.Getenv("LANTERN_AUTH_PEER_TOKEN"),
+		maxVertices:      maxVertices,
+		maxEdges:         maxEdges,
+		maxBytes:         maxBytes,
+		evictionPolicy:   evictionPolicy,
+		maxOutDegree:     maxOutDegree,
+		outDegreeRank:    outDegreeRank,
+		decayHalfLife:    time.Duration(decayHalfLife) * time.Second,
+		slidingTTL:       time.Duration(slidingTTL) * time.Second,
+	}, nil
+}
+
+func NewGraphCache(c *Config) (*graph.GraphCache[string, *v1.Vertex], error) {
+	cache := graph.NewGraphCache[string, *v1.Vertex](c.ttl)
+	cache.SetLimits(service.SizedLimits(graph.Limits[string, *v1.Vertex]{
+		MaxVertices:  c.maxVertices,
+		MaxEdges:     c.maxEdges,
+		MaxBytes:     c.maxBytes,
+		Policy:       c.evictionPolicy,
+		MaxOutDegree: c.maxOutDegree,
+		KeepBy:       c.outDegreeRank,
+	}))
+	if cache.EvictsByReads() && (c.walDir != "" || c.leader != "") {
+		return nil, fmt.Errorf("LANTERN_EVICTION_POLICY=%s cannot be used with LANTERN_WAL_DIR or LANTERN_REPLICATION_LEADER, since its evictions are neither logged nor replicated", c.evictionPolicy)
+	}
+	cache.SetHalfLife(c.decayHalfLife)
+	cache.SetSlidingTTL(c.slidingTTL)
+	return cache, nil
+}
+
+func NewSweeper(c *Config, cache *graph.GraphCache[string, *v1.Vertex]) *graph.Sweeper[string, *v1.Vertex] {
+	return graph.NewSweeper(cache, c.sweepInterval)
+}
+
+// NewNamespaces creates namespaces with LANTERN_DEFAULT_TTL_SECONDS and LANTERN_SWEEP_INTERVAL_SECONDS
+// unless they are specified on creation. cache is the default namespace.
+func NewNamespaces(c *Config, cache *graph.GraphCache[string, *v1.Vertex]) *service.Namespaces {
+	return service.NewNamespaces(cache, c.ttl, c.sweepInterval)
+}
+
+// NewWAL restores namespaces from the snapshot and the log, and opens the log to write to.
+func NewWAL(c *Config, namespaces *service.Namespaces) (*storage.WAL, error) {
+	seq, err := storage.LoadSnapshot(c.snapshotPath, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	if err := storage.ReplayWAL(c.walDir, seq, namespaces); err != nil {
+		return nil, err
+	}
+	return storage.OpenWAL(c.walDir, c.walSyncPolicy, namespaces)
+}
+
+func NewSnapshotter(c *Config, wal *storage.WAL) *storage.Snapshotter {
+	return storage.NewSnapshotter(c.snapshotPath, c.snapshotInterval, wal)
+}
+
+func NewLeader(wal *storage.WAL) *replication.Leader {
+	return replication.NewLeader(wal)
+}
+
+// NewCertReloader returns nil unless LANTERN_TLS_CERT_FILE is set. Clients must present
+// certificates signed by LANTERN_TLS_CLIENT_CA_FILE if it is set.
+func NewCertReloader(c *Config) (*certs.Reloader, error) {
+	if c.tlsCertFile == "" {
+		return nil, nil
+	}
+	if c.tlsKeyFile == "" {
+		return nil, errors.New("LANTERN_TLS_KEY_FILE must be set with LANTERN_TLS_CERT_FILE")
+	}
+	return certs.NewReloader(c.tlsCertFile, c.tlsKeyFile, c.tlsClientCAFile, c.tlsReload)
+}
+
+// NewDialOptions returns options to dial a leader or other nodes over TLS, verified with
+// LANTERN_TLS_CA_FILE or the system pool, if TLS is enabled. Requests carry
+// LANTERN_AUTH_PEER_TOKEN if it is set.
+func NewDialOptions(c *Config, r *certs.Reloader) ([]grpc.DialOption, error) {
+	if r == nil && c.authPeerToken == "" {
+		return nil, nil
+	}
+
+	options := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if r != nil {
+		var rootCAs *x509.CertPool
+		if c.tlsCAFile != "" {
+			pool, err := certs.LoadCertPool(c.tlsCAFile)
+			if err != nil {
+				return nil, err
+			}
+			rootCAs = pool
+		}
+		options[0] = grpc.WithTransportCredentials(credentials.NewTLS(r.ClientConfig(rootCAs)))
+	}
+	if c.authPeerToken != "" {
+		options = append(options, grpc.WithPerRPCCredentials(auth.BearerToken(c.authPeerToken)))
+	}
+	return options, nil
+}
+
+// NewFollower returns nil unless LANTERN_REPLICATION_LEADER is set.
+func NewFollower(c *Config, namespaces *service.Namespaces, options []grpc.DialOption) *replication.Follower {
+	if c.leader == "" {
+		return nil
+	}
+	return replication.NewFollower(c.leader, namespaces, options...)
+}
+
+// NewCluster returns nil unless LANTERN_CLUSTER_NODES is set.
+func NewCluster(c *Config, namespaces *service.Namespaces, options []grpc.DialOption) (*cluster.Cluster, error) {
+	if len(c.nodes) == 0 {
+		return nil, nil
 	}
+	return cluster.NewCluster(c.node, c.nodes, namespaces, options...)
 }
 
-func NewGraphCache(c *Config) *graph.GraphCache[string, *v1.Vertex] {
-	return graph.NewGraphCache[string, *v1.Vertex](c.ttl)
+// NewSink returns nil unless LANTERN_EXPIRY_SINK is set.
+func NewSink(c *Config) (sink.Sink, error) {
+	return sink.Parse(c.expirySink)
 }
 
-func NewListener() (net.Listener, error) {
-	return net.Listen("tcp", ":"+strconv.Itoa(NewConfig().port))
+func NewMetrics(cache *graph.GraphCache[string, *v1.Vertex], sweeper *graph.Sweeper[string, *v1.Vertex]) *metrics.Metrics {
+	return metrics.NewMetrics(cache, sweeper)
 }
 
-func NewGrpcServerOptions() []grpc.ServerOption {
-	return []grpc.ServerOption{}
+// NewMetricsServer returns an HTTP server of /metrics on LANTERN_METRICS_PORT.
+func NewMetricsServer(c *Config, m *metrics.Metrics) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	return &http.Server{
+		Addr:    ":" + strconv.Itoa(c.metricsPort),
+		Handler: mux,
+	}
+}
+
+// NewTracerProvider exports spans as LANTERN_TRACE_EXPORTER, or does not record them if it is not set.
+func NewTracerProvider(c *Config) (*sdktrace.TracerProvider, error) {
+	return tracing.NewTracerProvider(context.Background(), c.traceExporter)
+}
+
+// NewLogger returns a logger configured by LANTERN_LOG_LEVEL and LANTERN_LOG_FORMAT, and makes it
+// the default logger, which the standard log package writes to as well.
+func NewLogger(c *Config) (*slog.Logger, error) {
+	logger, err := logging.NewLogger(os.Stderr, c.logLevel, c.logFormat)
+	if err != nil {
+		return nil, err
+	}
+	slog.SetDefault(logger)
+	return logger, nil
+}
+
+// NewLoggingInterceptor samples logs of RPCs at LANTERN_LOG_SAMPLE_RATE.
+func NewLoggingInterceptor(c *Config, logger *slog.Logger) *logging.Interceptor {
+	return logging.NewInterceptor(logger, c.logSampleRate)
+}
+
+// NewAuthInterceptor returns nil unless LANTERN_AUTH_TOKENS_FILE or LANTERN_AUTH_JWT_KEY_FILE
+// is set. Tokens in the file are tried before JWTs.
+func NewAuthInterceptor(c *Config) (*auth.Interceptor, error) {
+	var chain auth.Chain
+	if c.authTokensFile != "" {
+		tokens, err := auth.LoadTokens(c.authTokensFile)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, tokens)
+	}
+	if c.authJWTKeyFile != "" {
+		jwt, err := auth.LoadJWTKey(c.authJWTKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, jwt)
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	return auth.NewInterceptor(chain), nil
+}
+
+func NewListener(c *Config) (net.Listener, error) {
+	return net.Listen("tcp", ":"+strconv.Itoa(c.port))
+}
+
+// NewGrpcServerOptions serves over TLS if a certificate is configured, and authenticates
+// requests if authentication is enabled. Rejected requests are still logged and counted.
+func NewGrpcServerOptions(m *metrics.Metrics, tp *sdktrace.TracerProvider, l *logging.Interceptor, a *auth.Interceptor, r *certs.Reloader) []grpc.ServerOption {
+	unary := []grpc.UnaryServerInterceptor{
+		otelgrpc.UnaryServerInterceptor(otelgrpc.WithTracerProvider(tp)),
+		l.Unary(),
+		m.UnaryServerInterceptor(),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		otelgrpc.StreamServerInterceptor(otelgrpc.WithTracerProvider(tp)),
+		l.Stream(),
+		m.StreamServerInterceptor(),
+	}
+	if a != nil {
+		unary = append(unary, a.Unary())
+		stream = append(stream, a.Stream())
+	}
+
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if r != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(r.ServerConfig())))
+	}
+	return options
 }
 
 func NewGrpcServer(options []grpc.ServerOption) *grpc.Server {
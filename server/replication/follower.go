@@ -0,0 +1,156 @@
+package replication
+
+import (
+	"context"
+	"fmt"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"github.com/anaregdesign/lantern/server/storage"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// retryInterval is the interval to reconnect to the leader.
+const retryInterval = 1 * time.Second
+
+// Follower applies mutations streamed from a leader to the caches of its namespaces.
+type Follower struct {
+	leader   string
+	graphs   storage.Graphs
+	sequence uint64
+	onApply  func(storage.Entry)
+	options  []grpc.DialOption
+
+	// synced is false while the cache may not match any sequence number of the leader,
+	// i.e. before the first connection and while a snapshot is being received.
+	synced bool
+}
+
+// NewFollower returns a follower of leader, which is dialed with options, or without
+// transport security if there are no options.
+func NewFollower(leader string, graphs storage.Graphs, options ...grpc.DialOption) *Follower {
+	if len(options) == 0 {
+		options = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	return &Follower{
+		leader:  leader,
+		graphs:  graphs,
+		options: options,
+	}
+}
+
+func (f *Follower) Leader() string {
+	return f.leader
+}
+
+// OnApply sets a function called with each mutation after it is applied to its namespace.
+// It is not called for snapshots. It must be set before Run.
+func (f *Follower) OnApply(fn func(storage.Entry)) {
+	f.onApply = fn
+}
+
+// Run follows the leader until ctx is done, reconnecting whenever the stream is interrupted.
+func (f *Follower) Run(ctx context.Context) {
+	for {
+		if err := f.follow(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("Replication is interrupted", "leader", f.leader, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (f *Follower) follow(ctx context.Context) error {
+	conn, err := grpc.DialContext(ctx, f.leader, f.options...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if !f.synced {
+		storage.Clear(f.graphs)
+		f.sequence = 0
+		f.synced = true
+	}
+
+	stream, err := NewReplicationServiceClient(conn).Replicate(ctx, &ReplicateRequest{Sequence: f.sequence})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch e := response.Event.(type) {
+		case *ReplicateResponse_Snapshot:
+			if e.Snapshot.First {
+				slog.Info("Receiving snapshot", "sequence", e.Snapshot.Sequence, "leader", f.leader)
+				f.synced = false
+				storage.Clear(f.graphs)
+			}
+			if err := storage.Restore(f.graphs, e.Snapshot); err != nil {
+				return err
+			}
+			if e.Snapshot.Last {
+				f.sequence = e.Snapshot.Sequence
+				f.synced = true
+			}
+
+		case *ReplicateResponse_Mutation:
+			if e.Mutation.Sequence != f.sequence+1 {
+				return fmt.Errorf("expected mutation %d, but got %d", f.sequence+1, e.Mutation.Sequence)
+			}
+			entry := storage.Entry{
+				Sequence:  e.Mutation.Sequence,
+				Namespace: e.Mutation.Namespace,
+				Mutation:  request(e.Mutation),
+			}
+			if entry.Namespace == "" {
+				entry.Namespace = namespace.Default
+			}
+			if err := entry.Apply(f.graphs); err != nil {
+				return err
+			}
+			f.sequence = e.Mutation.Sequence
+			if f.onApply != nil {
+				f.onApply(entry)
+			}
+		}
+	}
+}
+
+func request(m *Mutation) proto.Message {
+	switch r := m.Request.(type) {
+	case *Mutation_PutVertex:
+		return r.PutVertex
+	case *Mutation_DeleteVertex:
+		return r.DeleteVertex
+	case *Mutation_AddEdge:
+		return r.AddEdge
+	case *Mutation_PutEdge:
+		return r.PutEdge
+	case *Mutation_DeleteEdge:
+		return r.DeleteEdge
+	case *Mutation_Touch:
+		return r.Touch
+	case *Mutation_Ingest:
+		return r.Ingest
+	case *Mutation_CreateNamespace:
+		return r.CreateNamespace
+	case *Mutation_FlushNamespace:
+		return r.FlushNamespace
+	case *Mutation_DropNamespace:
+		return r.DropNamespace
+	default:
+		return nil
+	}
+}
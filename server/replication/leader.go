@@ -0,0 +1,162 @@
+package replication
+
+import (
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"github.com/anaregdesign/lantern/server/storage"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+)
+
+// bufferSize is the number of mutations buffered for each follower. A follower which falls
+// further behind is disconnected, and catches up again after reconnecting.
+const bufferSize = 4096
+
+// Leader streams mutations written to its WAL to followers.
+type Leader struct {
+	UnimplementedReplicationServiceServer
+	wal  *storage.WAL
+	once sync.Once
+	done chan struct{}
+}
+
+func NewLeader(wal *storage.WAL) *Leader {
+	return &Leader{
+		wal:  wal,
+		done: make(chan struct{}),
+	}
+}
+
+// Close ends all streams to followers, which never end by themselves.
+func (l *Leader) Close() {
+	l.once.Do(func() {
+		close(l.done)
+	})
+}
+
+// Replicate sends mutations after the sequence number a follower has applied. If they are
+// no longer in the log, a snapshot is sent first. The stream ends when a namespace which
+// evicts by reads is created, and followers are refused while it exists.
+func (l *Leader) Replicate(request *ReplicateRequest, stream ReplicationService_ReplicateServer) error {
+	slog.Info("Replicate", "sequence", request.Sequence)
+	if !l.wal.Reproducible() {
+		return status.Error(codes.FailedPrecondition, "leader evicts by reads, which are not replicated")
+	}
+
+	var subscription *storage.Subscription
+	if l.wal.Readable(request.Sequence) {
+		s, seq := l.wal.Subscribe(bufferSize)
+		defer s.Close()
+		subscription = s
+
+		if err := l.wal.Read(request.Sequence, seq, func(e storage.Entry) error {
+			return sendMutation(stream, e)
+		}); err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+	} else {
+		s, checkpoint := l.wal.SubscribeWithCheckpoint(bufferSize)
+		defer s.Close()
+		subscription = s
+
+		if err := sendSnapshot(stream, checkpoint); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case e, ok := <-subscription.C():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, subscription.Err().Error())
+			}
+			if err := sendMutation(stream, e); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+
+		case <-l.done:
+			return status.Error(codes.Unavailable, "leader is shutting down")
+		}
+	}
+}
+
+func sendSnapshot(stream ReplicationService_ReplicateServer, checkpoint *storage.Checkpoint) error {
+	// Hold back one chunk to know which is the last.
+	var pending *SnapshotChunk
+	first := true
+	send := func(chunk *SnapshotChunk, last bool) error {
+		chunk.Sequence = checkpoint.Sequence
+		chunk.First = first
+		chunk.Last = last
+		err := stream.Send(&ReplicateResponse{
+			Event: &ReplicateResponse_Snapshot{
+				Snapshot: chunk,
+			},
+		})
+		first = false
+		return err
+	}
+
+	if err := checkpoint.Chunks(func(chunk *SnapshotChunk) error {
+		if pending != nil {
+			if err := send(pending, false); err != nil {
+				return err
+			}
+		}
+		pending = chunk
+		return nil
+	}); err != nil {
+		return err
+	}
+	if pending == nil {
+		pending = &SnapshotChunk{Graph: &Graph{}}
+	}
+	return send(pending, true)
+}
+
+func sendMutation(stream ReplicationService_ReplicateServer, e storage.Entry) error {
+	m := &Mutation{
+		Sequence: e.Sequence,
+	}
+	if e.Namespace != namespace.Default {
+		m.Namespace = e.Namespace
+	}
+	switch r := e.Mutation.(type) {
+	case *PutVertexRequest:
+		m.Request = &Mutation_PutVertex{PutVertex: r}
+	case *DeleteVertexRequest:
+		m.Request = &Mutation_DeleteVertex{DeleteVertex: r}
+	case *AddEdgeRequest:
+		m.Request = &Mutation_AddEdge{AddEdge: r}
+	case *PutEdgeRequest:
+		m.Request = &Mutation_PutEdge{PutEdge: r}
+	case *DeleteEdgeRequest:
+		m.Request = &Mutation_DeleteEdge{DeleteEdge: r}
+	case *TouchRequest:
+		m.Request = &Mutation_Touch{Touch: r}
+	case *IngestRequest:
+		m.Request = &Mutation_Ingest{Ingest: r}
+	case *CreateNamespaceRequest:
+		if storage.EvictsByReads(r.Namespace) {
+			return status.Errorf(codes.FailedPrecondition, "leader evicts by reads in namespace %s, which are not replicated", r.Namespace.Name)
+		}
+		m.Request = &Mutation_CreateNamespace{CreateNamespace: r}
+	case *FlushNamespaceRequest:
+		m.Request = &Mutation_FlushNamespace{FlushNamespace: r}
+	case *DropNamespaceRequest:
+		m.Request = &Mutation_DropNamespace{DropNamespace: r}
+	default:
+		return status.Error(codes.Internal, storage.ErrUnknownMutation.Error())
+	}
+
+	return stream.Send(&ReplicateResponse{
+		Event: &ReplicateResponse_Mutation{
+			Mutation: m,
+		},
+	})
+}
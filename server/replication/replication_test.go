@@ -0,0 +1,162 @@
+package replication_test
+
+import (
+	"context"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	. "github.com/anaregdesign/lantern/server/replication"
+	"github.com/anaregdesign/lantern/server/service"
+	"github.com/anaregdesign/lantern/server/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func startLeader(t *testing.T, wal *storage.WAL) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	leader := NewLeader(wal)
+	server := grpc.NewServer()
+	RegisterReplicationServiceServer(server, leader)
+	go server.Serve(listener)
+	t.Cleanup(func() {
+		leader.Close()
+		server.GracefulStop()
+	})
+	return listener.Addr().String()
+}
+
+func newNamespaces(t *testing.T, cache *graph.GraphCache[string, *Vertex]) *service.Namespaces {
+	t.Helper()
+	namespaces := service.NewNamespaces(cache, time.Minute, time.Second)
+	t.Cleanup(func() { storage.Clear(namespaces) })
+	return namespaces
+}
+
+// waitWeight waits for the weight of an edge in namespace ns of graphs.
+func waitWeight(t *testing.T, graphs storage.Graphs, ns string, tail, head string, want float32) {
+	t.Helper()
+	weight := func() (float32, bool) {
+		if cache, ok := graphs.Cache(ns); ok {
+			return cache.GetWeight(tail, head)
+		}
+		return 0, false
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if w, ok := weight(); ok && w == want {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	w, ok := weight()
+	t.Fatalf("GetWeight(%s, %s) in %s = %v, %v, want %v", tail, head, ns, w, ok, want)
+}
+
+func TestFollower_Run(t *testing.T) {
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	addEdge := func(tail, head string, weight float32) *AddEdgeRequest {
+		return &AddEdgeRequest{Edges: []*Edge{{Tail: tail, Head: head, Weight: weight, Expiration: expiration}}}
+	}
+
+	tests := []struct {
+		name string
+		dir  string
+	}{
+		{name: "catch up from snapshot", dir: ""},
+		{name: "catch up from log", dir: t.TempDir()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wal, err := storage.OpenWAL(tt.dir, storage.SyncNever, newNamespaces(t, graph.NewGraphCache[string, *Vertex](time.Minute)))
+			if err != nil {
+				t.Fatalf("OpenWAL() error = %v", err)
+			}
+			defer wal.Close()
+			addr := startLeader(t, wal)
+			write := func(ns string, m proto.Message) {
+				t.Helper()
+				if err := wal.Write(ns, m); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+
+			// written before the follower connects
+			write(namespace.Default, addEdge("a", "b", 1))
+			write(namespace.Default, &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud"}})
+			write("fraud", addEdge("c", "d", 1))
+			write(namespace.Default, &CreateNamespaceRequest{Namespace: &Namespace{Name: "dropped"}})
+
+			cache := graph.NewGraphCache[string, *Vertex](time.Minute)
+			cache.AddEdgeWithTTL("stale", "edge", 1, time.Hour)
+			follower := newNamespaces(t, cache)
+			if err := follower.Create(&Namespace{Name: "stale"}); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go NewFollower(addr, follower).Run(ctx)
+			waitWeight(t, follower, namespace.Default, "a", "b", 1)
+			waitWeight(t, follower, "fraud", "c", "d", 1)
+
+			// written while the follower is connected
+			write(namespace.Default, addEdge("a", "b", 2))
+			write(namespace.Default, &DeleteVertexRequest{Key: "x"})
+			write("fraud", addEdge("c", "d", 2))
+			write(namespace.Default, &CreateNamespaceRequest{Namespace: &Namespace{Name: "recommender", DefaultTtlSeconds: 600}})
+			write("recommender", addEdge("e", "f", 1))
+			write(namespace.Default, &DropNamespaceRequest{Name: "dropped"})
+			waitWeight(t, follower, namespace.Default, "a", "b", 3)
+			waitWeight(t, follower, "fraud", "c", "d", 3)
+			waitWeight(t, follower, "recommender", "e", "f", 1)
+
+			if _, ok := cache.GetWeight("stale", "edge"); ok {
+				t.Errorf("follower keeps an edge which does not exist on the leader")
+			}
+			var names []string
+			for _, spec := range follower.Namespaces() {
+				names = append(names, spec.Name)
+			}
+			if want := []string{"fraud", "recommender"}; !reflect.DeepEqual(names, want) {
+				t.Errorf("Namespaces() = %v, want %v", names, want)
+			}
+			if specs := follower.Namespaces(); len(specs) == 2 && specs[1].DefaultTtlSeconds != 600 {
+				t.Errorf("default TTL of recommender = %v, want 600", specs[1].DefaultTtlSeconds)
+			}
+		})
+	}
+}
+
+func TestLeader_Replicate_evictsByReads(t *testing.T) {
+	cache := graph.NewGraphCache[string, *Vertex](time.Minute)
+	cache.SetLimits(graph.Limits[string, *Vertex]{MaxVertices: 10, Policy: graph.LRU})
+	wal, err := storage.OpenWAL("", storage.SyncNever, newNamespaces(t, cache))
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	defer wal.Close()
+
+	conn, err := grpc.Dial(startLeader(t, wal), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.Dial() error = %v", err)
+	}
+	defer conn.Close()
+	stream, err := NewReplicationServiceClient(conn).Replicate(context.Background(), &ReplicateRequest{})
+	if err != nil {
+		t.Fatalf("Replicate() error = %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Recv() error = %v, want %v", err, codes.FailedPrecondition)
+	}
+}
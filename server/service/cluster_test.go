@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/cluster"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startClusterServices serves n services of a cluster over loopback connections. Since tests
+// call the services directly, the servers reject LanternService calls which are not forwarded.
+func startClusterServices(t *testing.T, n int) []*LanternService {
+	t.Helper()
+	listeners := make([]net.Listener, n)
+	addrs := make([]string, n)
+	for i := range listeners {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("net.Listen() error = %v", err)
+		}
+		listeners[i] = listener
+		addrs[i] = listener.Addr().String()
+	}
+
+	forwardedOnly := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, "/graph.v1.LanternService/") && !cluster.Forwarded(ctx) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is not forwarded", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+	services := make([]*LanternService, n)
+	for i, listener := range listeners {
+		cache := graph.NewGraphCache[string, *Vertex](time.Minute)
+		namespaces := NewNamespaces(cache, time.Minute, time.Second)
+		t.Cleanup(func() { namespaces.close(errShuttingDown) })
+		wal, err := storage.OpenWAL("", storage.SyncNever, namespaces)
+		if err != nil {
+			t.Fatalf("OpenWAL() error = %v", err)
+		}
+		t.Cleanup(func() { wal.Close() })
+		c, err := cluster.NewCluster(addrs[i], addrs, namespaces)
+		if err != nil {
+			t.Fatalf("NewCluster() error = %v", err)
+		}
+		t.Cleanup(func() { c.Close() })
+		services[i] = NewLanternService(namespaces, wal, nil, c, nil)
+
+		server := grpc.NewServer(grpc.UnaryInterceptor(forwardedOnly))
+		RegisterLanternServiceServer(server, services[i])
+		RegisterClusterServiceServer(server, c)
+		go server.Serve(listener)
+		t.Cleanup(server.Stop)
+	}
+	return services
+}
+
+func TestLanternService_Cluster(t *testing.T) {
+	services := startClusterServices(t, 3)
+	ctx := context.Background()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+
+	var vertices []*Vertex
+	var edges []*Edge
+	for i := 0; i < 30; i++ {
+		key := "k" + strconv.Itoa(i)
+		vertices = append(vertices, &Vertex{Key: key, Value: &Vertex_Int64{Int64: int64(i)}, Expiration: expiration})
+		edges = append(edges, &Edge{Tail: key, Head: "k" + strconv.Itoa((i+1)%30), Weight: float32(i + 1), Expiration: expiration})
+	}
+	if _, err := services[0].PutVertex(ctx, &PutVertexRequest{Vertices: vertices}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := services[0].AddEdge(ctx, &AddEdgeRequest{Edges: edges}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	// Each vertex and edge is stored by the owner of its key or tail.
+	for i, s := range services {
+		for _, e := range edges {
+			if _, ok := s.cache.GetWeight(e.Tail, e.Head); ok != s.cluster.Owns(e.Tail) {
+				t.Errorf("node %d: %s -> %s is stored: %v, want %v", i, e.Tail, e.Head, ok, s.cluster.Owns(e.Tail))
+			}
+		}
+	}
+
+	// Any node serves any key from its owner.
+	for i, s := range services {
+		for j, v := range vertices {
+			got, err := s.GetVertex(ctx, &GetVertexRequest{Key: v.Key})
+			if err != nil || got.Vertex.GetInt64() != int64(j) {
+				t.Errorf("node %d: GetVertex(%s) = %v, %v, want %d", i, v.Key, got, err, j)
+			}
+		}
+		for _, e := range edges {
+			got, err := s.GetEdge(ctx, &GetEdgeRequest{Tail: e.Tail, Head: e.Head})
+			if err != nil || got.Edge.Weight != e.Weight {
+				t.Errorf("node %d: GetEdge(%s, %s) = %v, %v, want %v", i, e.Tail, e.Head, got, err, e.Weight)
+			}
+		}
+		if _, err := s.GetVertex(ctx, &GetVertexRequest{Key: "missing"}); status.Code(err) != codes.NotFound {
+			t.Errorf("node %d: GetVertex(missing) error = %v, want %v", i, err, codes.NotFound)
+		}
+	}
+}
+
+func TestLanternService_Cluster_Namespace(t *testing.T) {
+	services := startClusterServices(t, 3)
+	ctx := context.Background()
+	if _, err := services[1].CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud", DefaultTtlSeconds: 600}}); err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+	for i, s := range services {
+		n, ok := s.namespaces.get("fraud")
+		if !ok || n.defaultTTL != 10*time.Minute {
+			t.Fatalf("node %d: namespace fraud = %v, %v, want created with a TTL of 10m", i, n, ok)
+		}
+	}
+	if _, err := services[2].CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud"}}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateNamespace() error = %v, want %v", err, codes.AlreadyExists)
+	}
+
+	fraud := inNamespace("fraud")
+	var edges []*Edge
+	for i := 0; i < 30; i++ {
+		edges = append(edges, &Edge{Tail: "k" + strconv.Itoa(i), Head: "k" + strconv.Itoa((i+1)%30), Weight: float32(i + 1)})
+	}
+	if _, err := services[0].AddEdge(fraud, &AddEdgeRequest{Edges: edges}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+	for i, s := range services {
+		n, _ := s.namespaces.get("fraud")
+		for _, e := range edges {
+			if _, ok := n.cache.GetWeight(e.Tail, e.Head); ok != s.cluster.Owns(e.Tail) {
+				t.Errorf("node %d: %s -> %s is stored: %v, want %v", i, e.Tail, e.Head, ok, s.cluster.Owns(e.Tail))
+			}
+		}
+		if _, ok := s.cache.GetWeight(edges[0].Tail, edges[0].Head); ok {
+			t.Errorf("node %d: %s -> %s is stored in the default namespace", i, edges[0].Tail, edges[0].Head)
+		}
+		got, err := s.Illuminate(fraud, &IlluminateRequest{Seed: "k0", Step: 3, K: 1})
+		if err != nil {
+			t.Fatalf("node %d: Illuminate() error = %v", i, err)
+		}
+		if want := map[string]float32{"k0->k1": 1, "k1->k2": 2, "k2->k3": 3}; !reflect.DeepEqual(edgeSet(got.Graph), want) {
+			t.Errorf("node %d: Illuminate() edges = %v, want %v", i, edgeSet(got.Graph), want)
+		}
+	}
+
+	if _, err := services[2].DropNamespace(ctx, &DropNamespaceRequest{Name: "fraud"}); err != nil {
+		t.Fatalf("DropNamespace() error = %v", err)
+	}
+	for i, s := range services {
+		if _, ok := s.namespaces.get("fraud"); ok {
+			t.Errorf("node %d: namespace fraud is not dropped", i)
+		}
+	}
+}
+
+func TestLanternService_Cluster_DeleteVertex(t *testing.T) {
+	services := startClusterServices(t, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for _, s := range services {
+		go s.cache.Watch(ctx, 10*time.Millisecond)
+	}
+
+	// Edges to x live with their tails, which are spread over the nodes.
+	var edges []*Edge
+	for i := 0; i < 30; i++ {
+		edges = append(edges, &Edge{Tail: "k" + strconv.Itoa(i), Head: "x", Weight: 1})
+	}
+	if _, err := services[0].AddEdge(ctx, &AddEdgeRequest{Edges: edges}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+	if _, err := services[1].DeleteVertex(ctx, &DeleteVertexRequest{Key: "x"}); err != nil {
+		t.Fatalf("DeleteVertex() error = %v", err)
+	}
+	for i, s := range services {
+		for deadline := time.Now().Add(5 * time.Second); len(s.cache.InEdges("x")) > 0; time.Sleep(10 * time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Fatalf("node %d: edges to x = %v, want none", i, s.cache.InEdges("x"))
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	model "github.com/anaregdesign/papaya/graph"
+)
+
+var directions = map[Direction]graph.Direction{
+	Direction_DIRECTION_UNSPECIFIED: graph.Outbound,
+	Direction_DIRECTION_OUTBOUND:    graph.Outbound,
+	Direction_DIRECTION_INBOUND:     graph.Inbound,
+	Direction_DIRECTION_BOTH:        graph.Both,
+}
+
+// orient returns a graph whose edges point away from the seed when they are followed in direction,
+// so that trees rooted at the seed can be computed regardless of direction.
+// An inbound graph is reversed, and a graph explored in both directions is made symmetric,
+// where an edge keeps its own weight if the opposite edge exists as well.
+func orient(g *model.Graph[string, *Vertex], direction graph.Direction) *model.Graph[string, *Vertex] {
+	if direction == graph.Outbound {
+		return g
+	}
+
+	o := model.NewGraph[string, *Vertex]()
+	for k, v := range g.Vertices {
+		o.Vertices[k] = v
+	}
+	for tail, heads := range g.Edges {
+		for head, weight := range heads {
+			o.PutEdge(head, tail, weight)
+		}
+	}
+	if direction == graph.Both {
+		for tail, heads := range g.Edges {
+			for head, weight := range heads {
+				o.PutEdge(tail, head, weight)
+			}
+		}
+	}
+	return o
+}
+
+// restore turns edges of a tree computed from an oriented graph back into the edges of g.
+func restore(tree, g *model.Graph[string, *Vertex], direction graph.Direction) *model.Graph[string, *Vertex] {
+	if direction == graph.Outbound {
+		return tree
+	}
+
+	r := model.NewGraph[string, *Vertex]()
+	for k, v := range tree.Vertices {
+		r.Vertices[k] = v
+	}
+	for tail, heads := range tree.Edges {
+		for head := range heads {
+			if direction == graph.Inbound {
+				tail, head := head, tail
+				r.PutEdge(tail, head, g.Edges[tail][head])
+				continue
+			}
+			if weight, ok := g.Edges[tail][head]; ok {
+				r.PutEdge(tail, head, weight)
+			} else {
+				r.PutEdge(head, tail, g.Edges[head][tail])
+			}
+		}
+	}
+	return r
+}
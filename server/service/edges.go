@@ -0,0 +1,130 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"sort"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// edgeCursor is the position of the last edge of a page in the order of edges,
+// so that a page following it is stable while edges are added or deleted.
+type edgeCursor struct {
+	order    EdgeOrder
+	weight   float32
+	neighbor string
+}
+
+func (c edgeCursor) encode() string {
+	b := make([]byte, 5, 5+len(c.neighbor))
+	b[0] = byte(c.order)
+	binary.BigEndian.PutUint32(b[1:], math.Float32bits(c.weight))
+	b = append(b, c.neighbor...)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeEdgeCursor(token string) (edgeCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) < 5 {
+		return edgeCursor{}, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	return edgeCursor{
+		order:    EdgeOrder(b[0]),
+		weight:   math.Float32frombits(binary.BigEndian.Uint32(b[1:5])),
+		neighbor: string(b[5:]),
+	}, nil
+}
+
+func validateListEdgesRequest(request *ListEdgesRequest) error {
+	if request.Key == "" {
+		return status.Error(codes.InvalidArgument, "key must not be empty")
+	}
+	if request.PageSize > maxPageSize {
+		return status.Errorf(codes.InvalidArgument, "page_size must not be greater than %d", maxPageSize)
+	}
+	if _, ok := EdgeOrder_name[int32(request.Order)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown order: %d", request.Order)
+	}
+	return nil
+}
+
+// listEdges returns a page of edges in the order and the range of weights requested.
+// neighbor returns the key which identifies an edge among the edges listed,
+// i.e. the head of an outgoing edge or the tail of an incoming edge.
+func listEdges(request *ListEdgesRequest, edges []*Edge, neighbor func(*Edge) string) (*ListEdgesResponse, error) {
+	order := request.Order
+	if order == EdgeOrder_EDGE_ORDER_UNSPECIFIED {
+		order = EdgeOrder_EDGE_ORDER_WEIGHT_DESCENDING
+	}
+	less := func(w1 float32, n1 string, w2 float32, n2 string) bool {
+		if w1 != w2 {
+			if order == EdgeOrder_EDGE_ORDER_WEIGHT_ASCENDING {
+				return w1 < w2
+			}
+			return w1 > w2
+		}
+		return n1 < n2
+	}
+
+	var after *edgeCursor
+	if request.PageToken != "" {
+		c, err := decodeEdgeCursor(request.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if c.order != order {
+			return nil, status.Error(codes.InvalidArgument, "page token of another order")
+		}
+		after = &c
+	}
+
+	selected := make([]*Edge, 0, len(edges))
+	for _, e := range edges {
+		if request.MinWeight != nil && e.Weight < *request.MinWeight {
+			continue
+		}
+		if request.MaxWeight != nil && e.Weight > *request.MaxWeight {
+			continue
+		}
+		if after != nil && !less(after.weight, after.neighbor, e.Weight, neighbor(e)) {
+			continue
+		}
+		selected = append(selected, e)
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return less(selected[i].Weight, neighbor(selected[i]), selected[j].Weight, neighbor(selected[j]))
+	})
+
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	response := &ListEdgesResponse{}
+	if len(selected) > pageSize {
+		selected = selected[:pageSize]
+		last := selected[pageSize-1]
+		response.NextPageToken = edgeCursor{
+			order:    order,
+			weight:   last.Weight,
+			neighbor: neighbor(last),
+		}.encode()
+	}
+	response.Edges = selected
+	return response, nil
+}
+
+func edgeHead(e *Edge) string {
+	return e.Head
+}
+
+func edgeTail(e *Edge) string {
+	return e.Tail
+}
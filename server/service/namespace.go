@@ -0,0 +1,447 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"github.com/anaregdesign/lantern/server/storage"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"sync"
+	"time"
+)
+
+// graphNamespace is a graph served to requests of a namespace. Mutations of all namespaces,
+// including their creation, flushes and drops, are written to the WAL.
+type graphNamespace struct {
+	name          string
+	cache         *graph.GraphCache[string, *Vertex]
+	watchers      *watchers
+	defaultTTL    time.Duration
+	sweepInterval time.Duration
+	cancel        context.CancelFunc
+}
+
+func (n *graphNamespace) proto() *Namespace {
+	vertices, edges := n.cache.Size()
+	spec := n.spec()
+	spec.Vertices, spec.Edges = uint64(vertices), uint64(edges)
+	return spec
+}
+
+// spec returns the settings of the namespace, which create it again from the WAL or snapshots.
+func (n *graphNamespace) spec() *Namespace {
+	limits := n.cache.Limits()
+	return &Namespace{
+		Name:                 n.name,
+		DefaultTtlSeconds:    uint32(n.defaultTTL / time.Second),
+		MaxVertices:          uint64(limits.MaxVertices),
+		MaxEdges:             uint64(limits.MaxEdges),
+		MaxBytes:             uint64(limits.MaxBytes),
+		EvictionPolicy:       evictionPolicies[limits.Policy],
+		MaxOutDegree:         uint32(limits.MaxOutDegree),
+		OutDegreeRank:        degreeRanks[limits.KeepBy],
+		DecayHalfLifeSeconds: uint32(n.cache.HalfLife() / time.Second),
+		SlidingTtlSeconds:    uint32(n.cache.SlidingTTL() / time.Second),
+		SweepIntervalSeconds: uint32(n.sweepInterval / time.Second),
+	}
+}
+
+var evictionPolicies = map[graph.Policy]EvictionPolicy{
+	graph.Reject:          EvictionPolicy_EVICTION_POLICY_REJECT,
+	graph.LRU:             EvictionPolicy_EVICTION_POLICY_LRU,
+	graph.LFU:             EvictionPolicy_EVICTION_POLICY_LFU,
+	graph.SoonestExpiring: EvictionPolicy_EVICTION_POLICY_SOONEST_EXPIRING,
+	graph.LowestWeight:    EvictionPolicy_EVICTION_POLICY_LOWEST_WEIGHT,
+}
+
+var degreeRanks = map[graph.Rank]DegreeRank{
+	graph.RankWeight:  DegreeRank_DEGREE_RANK_WEIGHT,
+	graph.RankRecency: DegreeRank_DEGREE_RANK_RECENCY,
+}
+
+// rankOf returns the rank of r, keeping the heaviest edges if it is unspecified.
+func rankOf(r DegreeRank) (graph.Rank, bool) {
+	if r == DegreeRank_DEGREE_RANK_UNSPECIFIED {
+		return graph.RankWeight, true
+	}
+	for rank, d := range degreeRanks {
+		if d == r {
+			return rank, true
+		}
+	}
+	return 0, false
+}
+
+// policyOf returns the policy of p, rejecting mutations if it is unspecified.
+func policyOf(p EvictionPolicy) (graph.Policy, bool) {
+	if p == EvictionPolicy_EVICTION_POLICY_UNSPECIFIED {
+		return graph.Reject, true
+	}
+	for policy, e := range evictionPolicies {
+		if e == p {
+			return policy, true
+		}
+	}
+	return 0, false
+}
+
+// withExpirations returns a copy of m whose vertices and edges without expirations expire at expiration.
+func withExpirations(m proto.Message, expiration time.Time) proto.Message {
+	switch r := m.(type) {
+	case *PutVertexRequest:
+		r = proto.Clone(r).(*PutVertexRequest)
+		for _, v := range r.Vertices {
+			if v.Expiration == nil {
+				v.Expiration = timestamppb.New(expiration)
+			}
+		}
+		return r
+	case *AddEdgeRequest:
+		r = proto.Clone(r).(*AddEdgeRequest)
+		for _, e := range r.Edges {
+			if e.Expiration == nil {
+				e.Expiration = timestamppb.New(expiration)
+			}
+		}
+		return r
+	case *PutEdgeRequest:
+		r = proto.Clone(r).(*PutEdgeRequest)
+		for _, e := range r.Edges {
+			if e.Expiration == nil {
+				e.Expiration = timestamppb.New(expiration)
+			}
+		}
+		return r
+	case *IngestRequest:
+		r = proto.Clone(r).(*IngestRequest)
+		for _, v := range r.Vertices {
+			if v.Expiration == nil {
+				v.Expiration = timestamppb.New(expiration)
+			}
+		}
+		for _, e := range r.Edges {
+			if e.Expiration == nil {
+				e.Expiration = timestamppb.New(expiration)
+			}
+		}
+		return r
+	default:
+		return m
+	}
+}
+
+// Namespaces hosts the graph of the default namespace and graphs of other namespaces, each with
+// its own default TTL, limits and expiration sweep. It is the storage.Graphs which mutations
+// of the WAL, snapshots and replication are applied to.
+type Namespaces struct {
+	mu            sync.RWMutex
+	defaultTTL    time.Duration
+	sweepInterval time.Duration
+	root          *graphNamespace
+	members       map[string]*graphNamespace
+}
+
+// NewNamespaces returns namespaces whose default one is cache, and whose default TTL and sweep
+// interval are defaultTTL and sweepInterval unless they are specified on creation.
+func NewNamespaces(cache *graph.GraphCache[string, *Vertex], defaultTTL time.Duration, sweepInterval time.Duration) *Namespaces {
+	return &Namespaces{
+		defaultTTL:    defaultTTL,
+		sweepInterval: sweepInterval,
+		root: &graphNamespace{
+			name:          namespace.Default,
+			cache:         cache,
+			watchers:      newWatchers(),
+			defaultTTL:    defaultTTL,
+			sweepInterval: sweepInterval,
+		},
+		members: make(map[string]*graphNamespace),
+	}
+}
+
+// withDefaults returns a copy of spec whose TTL and sweep interval are the defaults of the
+// server unless they are specified, and which is written to the WAL to create the namespace
+// with the same settings when it is replayed, restored or replicated.
+func (ns *Namespaces) withDefaults(spec *Namespace) *Namespace {
+	spec = proto.Clone(spec).(*Namespace)
+	if spec.DefaultTtlSeconds == 0 {
+		spec.DefaultTtlSeconds = uint32(ns.defaultTTL / time.Second)
+	}
+	if spec.SweepIntervalSeconds == 0 {
+		spec.SweepIntervalSeconds = uint32(ns.sweepInterval / time.Second)
+	}
+	spec.Vertices, spec.Edges = 0, 0
+	return spec
+}
+
+// create starts a namespace described by spec.
+func (ns *Namespaces) create(spec *Namespace) (*graphNamespace, error) {
+	ttl := time.Duration(spec.DefaultTtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = ns.defaultTTL
+	}
+	interval := time.Duration(spec.SweepIntervalSeconds) * time.Second
+	if interval == 0 {
+		interval = ns.sweepInterval
+	}
+	policy, _ := policyOf(spec.EvictionPolicy)
+	rank, _ := rankOf(spec.OutDegreeRank)
+
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if _, ok := ns.members[spec.Name]; ok || spec.Name == namespace.Default {
+		return nil, storage.ErrNamespaceExists
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &graphNamespace{
+		name:          spec.Name,
+		cache:         graph.NewGraphCache[string, *Vertex](ttl),
+		watchers:      newWatchers(),
+		defaultTTL:    ttl,
+		sweepInterval: interval,
+		cancel:        cancel,
+	}
+	n.cache.SetLimits(SizedLimits(graph.Limits[string, *Vertex]{
+		MaxVertices:  int(spec.MaxVertices),
+		MaxEdges:     int(spec.MaxEdges),
+		MaxBytes:     int64(spec.MaxBytes),
+		Policy:       policy,
+		MaxOutDegree: int(spec.MaxOutDegree),
+		KeepBy:       rank,
+	}))
+	n.cache.SetHalfLife(time.Duration(spec.DecayHalfLifeSeconds) * time.Second)
+	n.cache.SetSlidingTTL(time.Duration(spec.SlidingTtlSeconds) * time.Second)
+	n.cache.OnExpire(func(vertices []graph.Vertex[string, *Vertex], edges []graph.Edge[string]) {
+		slog.Info("Expired", "namespace", n.name, "vertices", len(vertices), "edges", len(edges))
+		n.watchers.publish(expirationEvents(vertices, edges, time.Now()))
+	})
+	n.cache.OnEvict(func(vertices []graph.Vertex[string, *Vertex], edges []graph.Edge[string]) {
+		slog.Info("Evicted", "namespace", n.name, "vertices", len(vertices), "edges", len(edges))
+		n.watchers.publish(evictionEvents(vertices, edges, time.Now()))
+	})
+	go graph.NewSweeper(n.cache, interval).Watch(ctx)
+
+	ns.members[spec.Name] = n
+	return n, nil
+}
+
+// get returns the namespace of name, including the default one.
+func (ns *Namespaces) get(name string) (*graphNamespace, bool) {
+	if name == namespace.Default {
+		return ns.root, true
+	}
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+
+	n, ok := ns.members[name]
+	return n, ok
+}
+
+// list returns all namespaces including the default one, in the order of their names.
+func (ns *Namespaces) list() []*graphNamespace {
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+
+	namespaces := make([]*graphNamespace, 0, len(ns.members)+1)
+	namespaces = append(namespaces, ns.root)
+	for _, n := range ns.members {
+		namespaces = append(namespaces, n)
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].name < namespaces[j].name
+	})
+	return namespaces
+}
+
+// drop stops the sweep of a namespace and disconnects its watchers.
+func (ns *Namespaces) drop(name string) bool {
+	ns.mu.Lock()
+	n, ok := ns.members[name]
+	delete(ns.members, name)
+	ns.mu.Unlock()
+
+	if ok {
+		n.cancel()
+		n.watchers.close(errNamespaceDropped)
+	}
+	return ok
+}
+
+// close drops all namespaces other than the default one, and disconnects watchers of the
+// default one with err.
+func (ns *Namespaces) close(err error) {
+	for _, n := range ns.list() {
+		if n != ns.root {
+			ns.drop(n.name)
+		}
+	}
+	ns.root.watchers.close(err)
+}
+
+func (ns *Namespaces) Cache(name string) (*graph.GraphCache[string, *Vertex], bool) {
+	n, ok := ns.get(name)
+	if !ok {
+		return nil, false
+	}
+	return n.cache, true
+}
+
+func (ns *Namespaces) Namespaces() []*Namespace {
+	var specs []*Namespace
+	for _, n := range ns.list() {
+		if n != ns.root {
+			specs = append(specs, n.spec())
+		}
+	}
+	return specs
+}
+
+func (ns *Namespaces) Create(spec *Namespace) error {
+	_, err := ns.create(spec)
+	return err
+}
+
+func (ns *Namespaces) Drop(name string) {
+	ns.drop(name)
+}
+
+// feed publishes mutations written to wal to watchers of their namespaces until ctx is done.
+func (ns *Namespaces) feed(ctx context.Context, wal *storage.WAL) {
+	for {
+		subscription, _ := wal.Subscribe(watchBufferSize)
+		err := func() error {
+			defer subscription.Close()
+			for {
+				select {
+				case e, ok := <-subscription.C():
+					if !ok {
+						return subscription.Err()
+					}
+					ns.publish(e)
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		}()
+		if err == nil {
+			return
+		}
+		slog.Error("Events of mutations are lost", "error", err)
+	}
+}
+
+// publish sends events of a mutation to watchers of its namespace.
+func (ns *Namespaces) publish(e storage.Entry) {
+	if n, ok := ns.get(e.Namespace); ok {
+		now := time.Now()
+		n.watchers.publish(append(mutationEvents(e.Mutation, now), deletionEvents(e.Deleted, now)...))
+	}
+}
+
+// namespace returns the namespace of a request.
+func (s *LanternService) namespace(ctx context.Context) (*graphNamespace, error) {
+	name := namespace.FromIncomingContext(ctx)
+	n, ok := s.namespaces.get(name)
+	if !ok {
+		return nil, namespace.NotFound("namespace not found: " + name)
+	}
+	return n, nil
+}
+
+func validateNamespaceName(name string) error {
+	if err := namespace.Validate(name); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if name == namespace.Default {
+		return status.Errorf(codes.InvalidArgument, "namespace %s cannot be modified", name)
+	}
+	return nil
+}
+
+// writeNamespace writes a request to create, flush or drop a namespace to the WAL, which the
+// leader replicates to followers, so followers reject it like other mutations. In a cluster it
+// is sent to the other nodes too, even if the namespace already exists or is missing in this
+// one, so sending it again applies it to the nodes it has failed on.
+func (s *LanternService) writeNamespace(ctx context.Context, m proto.Message) error {
+	if s.follower != nil {
+		return status.Error(codes.FailedPrecondition, "read-only follower of "+s.follower.Leader())
+	}
+	err := s.wal.Write(namespace.Default, m)
+	if s.cluster != nil && (err == nil || errors.Is(err, storage.ErrNamespaceExists) || errors.Is(err, storage.ErrNamespaceNotFound)) {
+		if err := s.cluster.Broadcast(ctx, m); err != nil {
+			return clusterError(fmt.Errorf("namespace is not applied to all nodes: %w", err))
+		}
+	}
+	if err != nil {
+		return writeError(err)
+	}
+	return nil
+}
+
+func (s *LanternService) CreateNamespace(ctx context.Context, request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	if request.Namespace == nil {
+		return nil, status.Error(codes.InvalidArgument, "namespace must be set")
+	}
+	if err := validateNamespaceName(request.Namespace.Name); err != nil {
+		return nil, err
+	}
+	if _, ok := policyOf(request.Namespace.EvictionPolicy); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown eviction policy: %v", request.Namespace.EvictionPolicy)
+	}
+	if _, ok := rankOf(request.Namespace.OutDegreeRank); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown out-degree rank: %v", request.Namespace.OutDegreeRank)
+	}
+	spec := s.namespaces.withDefaults(request.Namespace)
+	if err := s.writeNamespace(ctx, &CreateNamespaceRequest{Namespace: spec}); err != nil {
+		return nil, err
+	}
+	n, ok := s.namespaces.get(spec.Name)
+	if !ok {
+		return nil, namespace.NotFound("namespace not found: " + spec.Name)
+	}
+	slog.Info("Created namespace", "namespace", n.name, "ttl", n.defaultTTL,
+		"max_vertices", spec.MaxVertices, "max_edges", spec.MaxEdges,
+		"max_bytes", spec.MaxBytes, "eviction_policy", spec.EvictionPolicy,
+		"max_out_degree", spec.MaxOutDegree, "out_degree_rank", spec.OutDegreeRank,
+		"decay_half_life_seconds", spec.DecayHalfLifeSeconds,
+		"sliding_ttl_seconds", spec.SlidingTtlSeconds)
+	return &CreateNamespaceResponse{Namespace: n.proto()}, nil
+}
+
+func (s *LanternService) ListNamespaces(ctx context.Context, request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	response := &ListNamespacesResponse{}
+	for _, n := range s.namespaces.list() {
+		response.Namespaces = append(response.Namespaces, n.proto())
+	}
+	return response, nil
+}
+
+func (s *LanternService) FlushNamespace(ctx context.Context, request *FlushNamespaceRequest) (*FlushNamespaceResponse, error) {
+	if err := validateNamespaceName(request.Name); err != nil {
+		return nil, err
+	}
+	if err := s.writeNamespace(ctx, &FlushNamespaceRequest{Name: request.Name}); err != nil {
+		return nil, err
+	}
+	slog.Info("Flushed namespace", "namespace", request.Name)
+	return &FlushNamespaceResponse{}, nil
+}
+
+func (s *LanternService) DropNamespace(ctx context.Context, request *DropNamespaceRequest) (*DropNamespaceResponse, error) {
+	if err := validateNamespaceName(request.Name); err != nil {
+		return nil, err
+	}
+	if err := s.writeNamespace(ctx, &DropNamespaceRequest{Name: request.Name}); err != nil {
+		return nil, err
+	}
+	slog.Info("Dropped namespace", "namespace", request.Name)
+	return &DropNamespaceResponse{}, nil
+}
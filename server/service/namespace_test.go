@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"github.com/anaregdesign/lantern/server/replication"
+	"github.com/anaregdesign/lantern/server/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func inNamespace(name string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(namespace.MetadataKey, name))
+}
+
+func TestLanternService_Namespace(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	if _, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud", MaxVertices: 3}}); err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+
+	// Without expirations, vertices and edges of a namespace live for its default TTL.
+	fraud := inNamespace("fraud")
+	if _, err := s.PutVertex(fraud, &PutVertexRequest{Vertices: []*Vertex{{Key: "a", Value: &Vertex_String_{String_: "fraud"}}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.AddEdge(fraud, &AddEdgeRequest{Edges: []*Edge{{Tail: "a", Head: "b", Weight: 1}}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	if _, err := s.GetVertex(ctx, &GetVertexRequest{Key: "a"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetVertex() in default namespace error = %v, want NotFound", err)
+	}
+	got, err := s.GetVertex(fraud, &GetVertexRequest{Key: "a"})
+	if err != nil || got.Vertex.GetString_() != "fraud" {
+		t.Errorf("GetVertex() = %v, %v, want fraud", got, err)
+	}
+	if _, err := s.GetEdge(fraud, &GetEdgeRequest{Tail: "a", Head: "b"}); err != nil {
+		t.Errorf("GetEdge() error = %v", err)
+	}
+
+	// a and b are stored, so only one more vertex is admitted.
+	if _, err := s.AddEdge(fraud, &AddEdgeRequest{Edges: []*Edge{{Tail: "b", Head: "c", Weight: 1}}}); err != nil {
+		t.Errorf("AddEdge() error = %v", err)
+	}
+	if _, err := s.PutVertex(fraud, &PutVertexRequest{Vertices: []*Vertex{{Key: "d"}}}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("PutVertex() beyond max_vertices error = %v, want ResourceExhausted", err)
+	}
+
+	list, err := s.ListNamespaces(ctx, &ListNamespacesRequest{})
+	if err != nil {
+		t.Fatalf("ListNamespaces() error = %v", err)
+	}
+	var names []string
+	for _, n := range list.Namespaces {
+		names = append(names, n.Name)
+		if n.Name == "fraud" && (n.Vertices != 3 || n.Edges != 2 || n.DefaultTtlSeconds != 60) {
+			t.Errorf("ListNamespaces() fraud = %v", n)
+		}
+	}
+	if want := []string{"default", "fraud"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListNamespaces() = %v, want %v", names, want)
+	}
+
+	if _, err := s.FlushNamespace(ctx, &FlushNamespaceRequest{Name: "fraud"}); err != nil {
+		t.Fatalf("FlushNamespace() error = %v", err)
+	}
+	if _, err := s.GetVertex(fraud, &GetVertexRequest{Key: "a"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetVertex() after flush error = %v, want NotFound", err)
+	}
+
+	if _, err := s.DropNamespace(ctx, &DropNamespaceRequest{Name: "fraud"}); err != nil {
+		t.Fatalf("DropNamespace() error = %v", err)
+	}
+	if _, err := s.PutVertex(fraud, &PutVertexRequest{Vertices: []*Vertex{{Key: "a"}}}); status.Code(err) != codes.NotFound {
+		t.Errorf("PutVertex() after drop error = %v, want NotFound", err)
+	}
+}
+
+func TestLanternService_Namespace_Watch(t *testing.T) {
+	s := newTestService(t)
+	client := startTestServer(t, s)
+	if _, err := s.CreateNamespace(context.Background(), &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud"}}); err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), namespace.MetadataKey, "fraud")
+	stream, err := client.Watch(ctx, &WatchRequest{})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, err := client.PutVertex(context.Background(), &PutVertexRequest{Vertices: []*Vertex{{Key: "default"}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := client.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{{Key: "fraud"}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	response, err := stream.Recv()
+	if err != nil || response.Event.Vertex.Key != "fraud" {
+		t.Errorf("Recv() = %v, %v, want an event of fraud", response, err)
+	}
+
+	if _, err := s.DropNamespace(context.Background(), &DropNamespaceRequest{Name: "fraud"}); err != nil {
+		t.Fatalf("DropNamespace() error = %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.NotFound {
+		t.Errorf("Recv() after drop error = %v, want NotFound", err)
+	}
+}
+
+func TestLanternService_Limits(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	s.cache.SetLimits(SizedLimits(graph.Limits[string, *Vertex]{MaxEdges: 1}))
+	if _, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: []*Edge{{Tail: "a", Head: "b", Weight: 1}}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+	if _, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: []*Edge{{Tail: "b", Head: "a", Weight: 1}}}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("AddEdge() beyond max_edges error = %v, want ResourceExhausted", err)
+	}
+
+	spec := &Namespace{Name: "lru", MaxVertices: 2, EvictionPolicy: EvictionPolicy_EVICTION_POLICY_LRU}
+	if _, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: spec}); err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+	lru := inNamespace("lru")
+	for _, key := range []string{"a", "b", "c"} {
+		if _, err := s.PutVertex(lru, &PutVertexRequest{Vertices: []*Vertex{{Key: key}}}); err != nil {
+			t.Fatalf("PutVertex(%s) error = %v", key, err)
+		}
+	}
+	if _, err := s.GetVertex(lru, &GetVertexRequest{Key: "a"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetVertex(a) error = %v, want NotFound", err)
+	}
+	list, err := s.ListNamespaces(ctx, &ListNamespacesRequest{})
+	if err != nil {
+		t.Fatalf("ListNamespaces() error = %v", err)
+	}
+	if n := list.Namespaces[1]; n.Vertices != 2 || n.MaxVertices != 2 || n.EvictionPolicy != EvictionPolicy_EVICTION_POLICY_LRU {
+		t.Errorf("ListNamespaces() lru = %v", n)
+	}
+
+	spec = &Namespace{Name: "hubs", MaxOutDegree: 1, DecayHalfLifeSeconds: 3600}
+	created, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: spec})
+	if err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+	if n := created.Namespace; n.MaxOutDegree != 1 || n.DecayHalfLifeSeconds != 3600 {
+		t.Errorf("CreateNamespace() = %v", n)
+	}
+	hubs := inNamespace("hubs")
+	edges := []*Edge{{Tail: "a", Head: "b", Weight: 1}, {Tail: "a", Head: "c", Weight: 2}}
+	if _, err := s.AddEdge(hubs, &AddEdgeRequest{Edges: edges}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+	if _, err := s.GetEdge(hubs, &GetEdgeRequest{Tail: "a", Head: "b"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetEdge(a, b) error = %v, want NotFound", err)
+	}
+	if _, err := s.GetEdge(hubs, &GetEdgeRequest{Tail: "a", Head: "c"}); err != nil {
+		t.Errorf("GetEdge(a, c) error = %v", err)
+	}
+}
+
+func TestLanternService_Namespace_InvalidArgument(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	if _, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud"}}); err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{name: "no namespace", call: func() error {
+			_, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{})
+			return err
+		}, want: codes.InvalidArgument},
+		{name: "invalid name", call: func() error {
+			_, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "a/b"}})
+			return err
+		}, want: codes.InvalidArgument},
+		{name: "unknown eviction policy", call: func() error {
+			_, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "lru", EvictionPolicy: 42}})
+			return err
+		}, want: codes.InvalidArgument},
+		{name: "unknown out-degree rank", call: func() error {
+			_, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "hubs", OutDegreeRank: 42}})
+			return err
+		}, want: codes.InvalidArgument},
+		{name: "existing", call: func() error {
+			_, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud"}})
+			return err
+		}, want: codes.AlreadyExists},
+		{name: "drop default", call: func() error {
+			_, err := s.DropNamespace(ctx, &DropNamespaceRequest{Name: namespace.Default})
+			return err
+		}, want: codes.InvalidArgument},
+		{name: "flush missing", call: func() error {
+			_, err := s.FlushNamespace(ctx, &FlushNamespaceRequest{Name: "missing"})
+			return err
+		}, want: codes.NotFound},
+		{name: "request to missing", call: func() error {
+			_, err := s.GetVertex(inNamespace("missing"), &GetVertexRequest{Key: "a"})
+			return err
+		}, want: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.want {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanternService_Namespace_replay(t *testing.T) {
+	dir := t.TempDir()
+	cache := graph.NewGraphCache[string, *Vertex](time.Minute)
+	namespaces := NewNamespaces(cache, time.Minute, time.Second)
+	t.Cleanup(func() { namespaces.close(errShuttingDown) })
+	wal, err := storage.OpenWAL(dir, storage.SyncAlways, namespaces)
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	s := NewLanternService(namespaces, wal, nil, nil, nil)
+	ctx := context.Background()
+	created, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud", MaxVertices: 10}})
+	if err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+	if _, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: &Namespace{Name: "dropped"}}); err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+	if _, err := s.PutVertex(inNamespace("fraud"), &PutVertexRequest{Vertices: []*Vertex{{Key: "a"}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{{Key: "b"}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.DropNamespace(ctx, &DropNamespaceRequest{Name: "dropped"}); err != nil {
+		t.Fatalf("DropNamespace() error = %v", err)
+	}
+	if err := wal.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	replayed := NewNamespaces(graph.NewGraphCache[string, *Vertex](time.Minute), time.Minute, time.Second)
+	t.Cleanup(func() { replayed.close(errShuttingDown) })
+	if err := storage.ReplayWAL(dir, 0, replayed); err != nil {
+		t.Fatalf("ReplayWAL() error = %v", err)
+	}
+	if got := replayed.Namespaces(); len(got) != 1 || !proto.Equal(got[0], created.Namespace) {
+		t.Errorf("Namespaces() = %v, want [%v]", got, created.Namespace)
+	}
+	fraud, ok := replayed.Cache("fraud")
+	if !ok {
+		t.Fatalf("Cache(fraud) is missing")
+	}
+	if _, ok := fraud.GetVertex("a"); !ok {
+		t.Errorf("vertex a of fraud is not replayed")
+	}
+	if _, ok := replayed.root.cache.GetVertex("b"); !ok {
+		t.Errorf("vertex b of the default namespace is not replayed")
+	}
+}
+
+func TestLanternService_Namespace_NotFound(t *testing.T) {
+	s := newTestService(t)
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "read", call: func() error {
+			_, err := s.GetVertex(inNamespace("missing"), &GetVertexRequest{Key: "a"})
+			return err
+		}},
+		{name: "write", call: func() error {
+			_, err := s.PutVertex(inNamespace("missing"), &PutVertexRequest{Vertices: []*Vertex{{Key: "a"}}})
+			return err
+		}},
+		{name: "flush", call: func() error {
+			_, err := s.FlushNamespace(context.Background(), &FlushNamespaceRequest{Name: "missing"})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, _ := status.FromError(tt.call())
+			var reason string
+			for _, d := range st.Details() {
+				if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == namespace.ErrorDomain {
+					reason = info.Reason
+				}
+			}
+			if st.Code() != codes.NotFound || reason != namespace.NotFoundReason {
+				t.Errorf("error = %v with reason %q, want %v with reason %q", st.Err(), reason, codes.NotFound, namespace.NotFoundReason)
+			}
+		})
+	}
+
+	// A missing vertex is not told as a missing namespace.
+	_, err := s.GetVertex(context.Background(), &GetVertexRequest{Key: "a"})
+	if st, _ := status.FromError(err); st.Code() != codes.NotFound || len(st.Details()) > 0 {
+		t.Errorf("GetVertex() error = %v with details %v, want %v without details", err, st.Details(), codes.NotFound)
+	}
+}
+
+func TestLanternService_Namespace_follower(t *testing.T) {
+	s := newTestService(t)
+	s.follower = replication.NewFollower("127.0.0.1:0", s.namespaces)
+	_, err := s.CreateNamespace(context.Background(), &CreateNamespaceRequest{Namespace: &Namespace{Name: "fraud"}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateNamespace() error = %v, want %v", err, codes.FailedPrecondition)
+	}
+	if n := len(s.namespaces.list()); n != 1 {
+		t.Errorf("len(list()) = %d, want 1", n)
+	}
+}
+
+func TestLanternService_Namespace_SlidingTTL(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	spec := &Namespace{Name: "sessions", DefaultTtlSeconds: 1, SlidingTtlSeconds: 3600}
+	created, err := s.CreateNamespace(ctx, &CreateNamespaceRequest{Namespace: spec})
+	if err != nil {
+		t.Fatalf("CreateNamespace() error = %v", err)
+	}
+	if created.Namespace.SlidingTtlSeconds != 3600 {
+		t.Errorf("CreateNamespace() = %v, want sliding TTL", created.Namespace)
+	}
+	sessions := inNamespace("sessions")
+	if _, err := s.PutVertex(sessions, &PutVertexRequest{Vertices: []*Vertex{{Key: "a"}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.GetVertex(sessions, &GetVertexRequest{Key: "a"}); err != nil {
+		t.Fatalf("GetVertex() error = %v", err)
+	}
+	scan, err := s.ScanVertices(sessions, &ScanVerticesRequest{IncludeExpirations: true})
+	if err != nil {
+		t.Fatalf("ScanVertices() error = %v", err)
+	}
+	if got := scan.Vertices[0].Expiration.AsTime(); got.Before(time.Now().Add(59 * time.Minute)) {
+		t.Errorf("expiration after GetVertex() = %v, want extended by an hour", got)
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"container/heap"
+	"encoding/base64"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"path"
+	"sort"
+	"strings"
+)
+
+// encodeVertexCursor returns a page token of the key of the last vertex of a page.
+// Keys are listed in order, so the next page starts after the key even if vertices are
+// added or deleted in the meantime.
+func encodeVertexCursor(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeVertexCursor(token string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) == 0 {
+		return "", status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	return string(b), nil
+}
+
+func validateScanVerticesRequest(request *ScanVerticesRequest) error {
+	if request.PageSize > maxPageSize {
+		return status.Errorf(codes.InvalidArgument, "page_size must not be greater than %d", maxPageSize)
+	}
+	if _, err := path.Match(request.Pattern, ""); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid pattern: %s", request.Pattern)
+	}
+	if request.PageToken != "" {
+		if _, err := decodeVertexCursor(request.PageToken); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchVertex reports whether key is selected by the prefix and the pattern of request.
+func matchVertex(request *ScanVerticesRequest, key string) bool {
+	if !strings.HasPrefix(key, request.Prefix) {
+		return false
+	}
+	if request.Pattern == "" {
+		return true
+	}
+	ok, _ := path.Match(request.Pattern, key)
+	return ok
+}
+
+// scannedVertex returns v with its value and expiration only if they are requested.
+func scannedVertex(request *ScanVerticesRequest, v graph.Vertex[string, *Vertex]) *Vertex {
+	vertex := &Vertex{Key: v.Key}
+	if request.IncludeValues {
+		if v.Value != nil {
+			vertex.Value = v.Value.Value
+		} else {
+			vertex.Value = &Vertex_Nil{Nil: true}
+		}
+	}
+	if request.IncludeExpirations {
+		vertex.Expiration = timestamppb.New(v.Expiration)
+	}
+	return vertex
+}
+
+// vertexPage keeps vertices with the smallest keys up to its size, so that a page is selected
+// without sorting all vertices. It is a max-heap of keys, whose root is the first to drop.
+type vertexPage struct {
+	size     int
+	vertices []graph.Vertex[string, *Vertex]
+}
+
+// newVertexPage returns a page of request, which keeps one more vertex than the page size
+// to tell whether there is a next page.
+func newVertexPage(request *ScanVerticesRequest) *vertexPage {
+	return &vertexPage{size: pageSizeOf(request) + 1}
+}
+
+func (p *vertexPage) Len() int           { return len(p.vertices) }
+func (p *vertexPage) Less(i, j int) bool { return p.vertices[i].Key > p.vertices[j].Key }
+func (p *vertexPage) Swap(i, j int)      { p.vertices[i], p.vertices[j] = p.vertices[j], p.vertices[i] }
+
+func (p *vertexPage) Push(x any) {
+	p.vertices = append(p.vertices, x.(graph.Vertex[string, *Vertex]))
+}
+
+func (p *vertexPage) Pop() any {
+	v := p.vertices[len(p.vertices)-1]
+	p.vertices = p.vertices[:len(p.vertices)-1]
+	return v
+}
+
+// add keeps v if its key is one of the smallest ones.
+func (p *vertexPage) add(v graph.Vertex[string, *Vertex]) {
+	if len(p.vertices) < p.size {
+		heap.Push(p, v)
+		return
+	}
+	if v.Key < p.vertices[0].Key {
+		p.vertices[0] = v
+		heap.Fix(p, 0)
+	}
+}
+
+func pageSizeOf(request *ScanVerticesRequest) int {
+	if request.PageSize == 0 {
+		return defaultPageSize
+	}
+	return int(request.PageSize)
+}
+
+// scanVertices returns a page of vertices in the order of their keys. vertices must be
+// after the cursor of request, and more reports whether there are vertices other than them.
+func scanVertices(request *ScanVerticesRequest, vertices []*Vertex, more bool) *ScanVerticesResponse {
+	sort.Slice(vertices, func(i, j int) bool {
+		return vertices[i].Key < vertices[j].Key
+	})
+
+	pageSize := pageSizeOf(request)
+	response := &ScanVerticesResponse{}
+	if len(vertices) > pageSize || (more && len(vertices) == pageSize) {
+		vertices = vertices[:pageSize]
+		response.NextPageToken = encodeVertexCursor(vertices[pageSize-1].Key)
+	}
+	response.Vertices = vertices
+	return response
+}
@@ -2,47 +2,193 @@ package service
 
 import (
 	"context"
+	"errors"
 	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
-	"github.com/anaregdesign/papaya/cache/graph"
+	"github.com/anaregdesign/lantern/server/certs"
+	"github.com/anaregdesign/lantern/server/cluster"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"github.com/anaregdesign/lantern/server/replication"
+	"github.com/anaregdesign/lantern/server/sink"
+	"github.com/anaregdesign/lantern/server/storage"
+	model "github.com/anaregdesign/papaya/graph"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"log"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"math"
 	"net"
+	"net/http"
 	"time"
 )
 
+var tracer = otel.Tracer("github.com/anaregdesign/lantern/server/service")
+
 // Avoiding bug of `wire`. Generic type is not supported.
 
 type LanternService struct {
 	UnimplementedLanternServiceServer
-	cache *graph.GraphCache[string, *Vertex]
+	cache      *graph.GraphCache[string, *Vertex]
+	wal        *storage.WAL
+	follower   *replication.Follower
+	cluster    *cluster.Cluster
+	sink       sink.Sink
+	watchers   *watchers
+	namespaces *Namespaces
+}
+
+// NewLanternService returns a service of namespaces, which rejects mutations if follower is not nil,
+// and serves only its own shard of the graph if cluster is not nil.
+// Expired and evicted vertices and edges of the default namespace are sent to sink unless it is nil.
+func NewLanternService(namespaces *Namespaces, wal *storage.WAL, follower *replication.Follower, cluster *cluster.Cluster, sink sink.Sink) *LanternService {
+	s := &LanternService{
+		cache:      namespaces.root.cache,
+		wal:        wal,
+		follower:   follower,
+		cluster:    cluster,
+		sink:       sink,
+		watchers:   namespaces.root.watchers,
+		namespaces: namespaces,
+	}
+
+	cache := s.cache
+	cache.OnExpire(func(vertices []graph.Vertex[string, *Vertex], edges []graph.Edge[string]) {
+		slog.Info("Expired", "vertices", len(vertices), "edges", len(edges))
+		events := expirationEvents(vertices, edges, time.Now())
+		s.watchers.publish(events)
+		if s.sink != nil {
+			if err := s.sink.Send(events); err != nil {
+				slog.Error("Failed to send expired vertices and edges to sink", "error", err)
+			}
+		}
+	})
+	cache.OnEvict(func(vertices []graph.Vertex[string, *Vertex], edges []graph.Edge[string]) {
+		slog.Info("Evicted", "vertices", len(vertices), "edges", len(edges))
+		events := evictionEvents(vertices, edges, time.Now())
+		s.watchers.publish(events)
+		if s.sink != nil {
+			if err := s.sink.Send(events); err != nil {
+				slog.Error("Failed to send evicted vertices and edges to sink", "error", err)
+			}
+		}
+	})
+	if follower != nil {
+		follower.OnApply(namespaces.publish)
+	}
+	return s
+}
+
+// write writes a mutation to the namespace of a request. Vertices and edges without expirations
+// live for the default TTL of the namespace.
+func (s *LanternService) write(ctx context.Context, m proto.Message) error {
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return err
+	}
+	if s.follower != nil {
+		return status.Error(codes.FailedPrecondition, "read-only follower of "+s.follower.Leader())
+	}
+	m = withExpirations(m, time.Now().Add(n.defaultTTL))
+	var forwardErr error
+	if s.cluster != nil {
+		// The part of this node is written even if other nodes fail, since the error names
+		// the parts to send again, and sending all of them again would add weights twice.
+		m, forwardErr = s.cluster.Forward(ctx, m)
+	}
+	if m != nil {
+		if err := s.wal.Write(n.name, m); err != nil {
+			return writeError(errors.Join(err, forwardErr))
+		}
+	}
+	if forwardErr != nil {
+		return clusterError(forwardErr)
+	}
+	return nil
+}
+
+// writeError reports mutations rejected for exceeding the limits of a graph as
+// codes.ResourceExhausted, mutations of a missing or existing namespace as codes.NotFound or
+// codes.AlreadyExists, namespaces which cannot be logged as codes.FailedPrecondition, and any
+// other failure as codes.Internal.
+func writeError(err error) error {
+	switch {
+	case errors.Is(err, graph.ErrLimitExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, storage.ErrNamespaceNotFound):
+		return namespace.NotFound(err.Error())
+	case errors.Is(err, storage.ErrNamespaceExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, storage.ErrEvictionNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// clusterError keeps the status of an error returned by another node, and reports
+// any other failure to reach other nodes as codes.Unavailable.
+func clusterError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Unavailable, err.Error())
 }
 
-func NewLanternService(cache *graph.GraphCache[string, *Vertex]) *LanternService {
-	return &LanternService{
-		cache: cache,
+func (s *LanternService) neighbor(ctx context.Context, seed string, step int, k int, tfidf bool, direction graph.Direction) (*model.Graph[string, *Vertex], error) {
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
 	}
+	if s.cluster != nil {
+		g, err := s.cluster.Neighbor(ctx, seed, step, k, tfidf, direction)
+		if err != nil {
+			return nil, clusterError(err)
+		}
+		return g, nil
+	}
+	return n.cache.NeighborWithDirection(seed, step, k, tfidf, direction), nil
 }
 
 func (s *LanternService) Illuminate(ctx context.Context, request *IlluminateRequest) (*IlluminateResponse, error) {
-	log.Printf("Illuminate: %v", request)
-	g := s.cache.Neighbor(request.Seed, int(request.Step), int(request.Step), request.Tfidf)
+	if err := validateIlluminateRequest(request); err != nil {
+		return nil, err
+	}
+	direction := directions[request.Direction]
+	neighborCtx, span := tracer.Start(ctx, "neighbor")
+	g, err := s.neighbor(neighborCtx, request.Seed, int(request.Step), int(request.K), request.Tfidf, direction)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("lantern.vertices", len(g.Vertices)))
+	span.End()
+	if _, ok := g.Vertices[request.Seed]; !ok {
+		return nil, status.Errorf(codes.NotFound, "vertex not found: %s", request.Seed)
+	}
 
+	_, span = tracer.Start(ctx, "optimize", trace.WithAttributes(attribute.String("lantern.optimization", request.Optimization.String())))
 	switch request.Optimization {
 	case Optimization_OPTIMIZATION_UNSPECIFIED:
 		// do nothing
 	case Optimization_OPTIMIZATION_MINIMUM_SPANNING_TREE:
-		g = g.MinimumSpanningTree(request.Seed, false)
+		g = restore(orient(g, direction).MinimumSpanningTree(request.Seed, false), g, direction)
 
 	case Optimization_OPTIMIZATION_MAXIMUM_SPANNING_TREE:
-		g = g.MinimumSpanningTree(request.Seed, true)
+		g = restore(orient(g, direction).MinimumSpanningTree(request.Seed, true), g, direction)
 
 	case Optimization_OPTIMIZATION_SHORTEST_PATH_TREE:
-		g = g.ShortestPathTree(request.Seed, func(weight float32) float32 { return weight })
+		g = restore(orient(g, direction).ShortestPathTree(request.Seed, func(weight float32) float32 { return weight }), g, direction)
 
 	case Optimization_OPTIMIZATION_SHORTEST_PATH_TREE_INVERSE:
-		g = g.ShortestPathTree(request.Seed, func(weight float32) float32 { return 1 / weight })
+		g = restore(orient(g, direction).ShortestPathTree(request.Seed, func(weight float32) float32 { return 1 / weight }), g, direction)
 	}
+	span.End()
 
 	var vertices []*Vertex
 	for k, v := range g.Vertices {
@@ -78,9 +224,65 @@ func (s *LanternService) Illuminate(ctx context.Context, request *IlluminateRequ
 	}, nil
 }
 
+func validateIlluminateRequest(request *IlluminateRequest) error {
+	if request.Seed == "" {
+		return status.Error(codes.InvalidArgument, "seed must not be empty")
+	}
+	if request.Step == 0 {
+		return status.Error(codes.InvalidArgument, "step must be greater than 0")
+	}
+	if request.K == 0 {
+		return status.Error(codes.InvalidArgument, "k must be greater than 0")
+	}
+	if _, ok := Optimization_name[int32(request.Optimization)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown optimization: %d", request.Optimization)
+	}
+	if _, ok := directions[request.Direction]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown direction: %d", request.Direction)
+	}
+	return nil
+}
+
+func validateVertices(vertices []*Vertex) error {
+	for _, v := range vertices {
+		if v.GetKey() == "" {
+			return status.Error(codes.InvalidArgument, "key must not be empty")
+		}
+	}
+	return nil
+}
+
+func validateEdgeKey(tail, head string) error {
+	if tail == "" || head == "" {
+		return status.Error(codes.InvalidArgument, "tail and head must not be empty")
+	}
+	return nil
+}
+
+func validateEdges(edges []*Edge) error {
+	for _, e := range edges {
+		if err := validateEdgeKey(e.Tail, e.Head); err != nil {
+			return err
+		}
+		if math.IsNaN(float64(e.Weight)) || math.IsInf(float64(e.Weight), 0) {
+			return status.Errorf(codes.InvalidArgument, "weight of %s -> %s must be finite", e.Tail, e.Head)
+		}
+	}
+	return nil
+}
+
 func (s *LanternService) GetVertex(ctx context.Context, request *GetVertexRequest) (*GetVertexResponse, error) {
-	log.Printf("GetVertex: %v", request)
-	if v, ok := s.cache.GetVertex(request.GetKey()); ok {
+	if request.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key must not be empty")
+	}
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.cluster != nil && !s.cluster.Local(ctx, request.GetKey()) {
+		return s.cluster.Node(request.GetKey()).GetVertex(cluster.Forwarding(ctx), request)
+	}
+	if v, ok := n.cache.GetVertex(request.GetKey()); ok {
 		if v == nil {
 			return &GetVertexResponse{
 				Vertex: &Vertex{
@@ -97,33 +299,104 @@ func (s *LanternService) GetVertex(ctx context.Context, request *GetVertexReques
 			Status: Status_STATUS_OK,
 		}, nil
 	}
-	return nil, status.Error(404, "Vertex not found")
+	return nil, status.Errorf(codes.NotFound, "vertex not found: %s", request.GetKey())
+}
+
+// GetVertices returns vertices of keys, and keys which are not found. Duplicated keys are
+// returned once.
+func (s *LanternService) GetVertices(ctx context.Context, request *GetVerticesRequest) (*GetVerticesResponse, error) {
+	keys := make([]string, 0, len(request.Keys))
+	seen := make(map[string]struct{}, len(request.Keys))
+	for _, key := range request.Keys {
+		if key == "" {
+			return nil, status.Error(codes.InvalidArgument, "key must not be empty")
+		}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]*Vertex, len(keys))
+	local := keys
+	if s.cluster != nil {
+		var remote []*Vertex
+		var err error
+		local, remote, err = s.cluster.GetVertices(ctx, keys)
+		if err != nil {
+			return nil, clusterError(err)
+		}
+		for _, v := range remote {
+			found[v.Key] = v
+		}
+	}
+	for _, key := range local {
+		v, ok := n.cache.GetVertex(key)
+		if !ok {
+			continue
+		}
+		if v == nil {
+			v = &Vertex{
+				Key: key,
+				Value: &Vertex_Nil{
+					Nil: true,
+				},
+			}
+		}
+		found[key] = v
+	}
+
+	response := &GetVerticesResponse{
+		Vertices: make([]*Vertex, 0, len(found)),
+		Status:   Status_STATUS_OK,
+	}
+	for _, key := range keys {
+		if v, ok := found[key]; ok {
+			response.Vertices = append(response.Vertices, v)
+		} else {
+			response.Missing = append(response.Missing, key)
+		}
+	}
+	return response, nil
 }
 
 func (s *LanternService) PutVertex(ctx context.Context, request *PutVertexRequest) (*PutVertexResponse, error) {
-	log.Printf("PutVertex: %v", request)
-	for _, v := range request.Vertices {
-		s.cache.AddVertexWithExpiration(v.Key, v, v.Expiration.AsTime())
+	if err := validateVertices(request.Vertices); err != nil {
+		return nil, err
+	}
+	if err := s.write(ctx, request); err != nil {
+		return nil, err
 	}
 	return &PutVertexResponse{Status: Status_STATUS_OK}, nil
 }
 func (s *LanternService) DeleteVertex(ctx context.Context, in *DeleteVertexRequest) (*DeleteVertexResponse, error) {
-	log.Printf("DeleteVertex: %v", in)
-	s.cache.DeleteVertex(in.GetKey())
+	if in.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key must not be empty")
+	}
+	if err := s.write(ctx, in); err != nil {
+		return nil, err
+	}
 	return &DeleteVertexResponse{Status: Status_STATUS_OK}, nil
 }
 
 func (s *LanternService) GetEdge(ctx context.Context, request *GetEdgeRequest) (*GetEdgeResponse, error) {
-	log.Printf("GetEdge: %v", request)
-	w, ok := s.cache.GetWeight(request.Tail, request.Head)
+	if err := validateEdgeKey(request.Tail, request.Head); err != nil {
+		return nil, err
+	}
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.cluster != nil && !s.cluster.Local(ctx, request.Tail) {
+		return s.cluster.Node(request.Tail).GetEdge(cluster.Forwarding(ctx), request)
+	}
+	w, ok := n.cache.GetWeight(request.Tail, request.Head)
 	if !ok {
-		return &GetEdgeResponse{
-			Edge: &Edge{
-				Tail:   request.Tail,
-				Head:   request.Head,
-				Weight: 0,
-			},
-		}, nil
+		return nil, status.Errorf(codes.NotFound, "edge not found: %s -> %s", request.Tail, request.Head)
 	}
 	return &GetEdgeResponse{
 		Edge: &Edge{
@@ -134,52 +407,354 @@ func (s *LanternService) GetEdge(ctx context.Context, request *GetEdgeRequest) (
 	}, nil
 }
 
+// ListOutEdges returns a page of edges from request.Key.
+func (s *LanternService) ListOutEdges(ctx context.Context, request *ListEdgesRequest) (*ListEdgesResponse, error) {
+	if err := validateListEdgesRequest(request); err != nil {
+		return nil, err
+	}
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.cluster != nil && !s.cluster.Local(ctx, request.Key) {
+		return s.cluster.Node(request.Key).ListOutEdges(cluster.Forwarding(ctx), request)
+	}
+
+	var edges []*Edge
+	for head, weight := range n.cache.OutEdges(request.Key) {
+		edges = append(edges, &Edge{
+			Tail:   request.Key,
+			Head:   head,
+			Weight: weight,
+		})
+	}
+	return listEdges(request, edges, edgeHead)
+}
+
+// ListInEdges returns a page of edges to request.Key.
+func (s *LanternService) ListInEdges(ctx context.Context, request *ListEdgesRequest) (*ListEdgesResponse, error) {
+	if err := validateListEdgesRequest(request); err != nil {
+		return nil, err
+	}
+
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var edges []*Edge
+	for tail, weight := range n.cache.InEdges(request.Key) {
+		edges = append(edges, &Edge{
+			Tail:   tail,
+			Head:   request.Key,
+			Weight: weight,
+		})
+	}
+	if s.cluster != nil {
+		remote, err := s.cluster.ListInEdges(ctx, request)
+		if err != nil {
+			return nil, clusterError(err)
+		}
+		edges = append(edges, remote...)
+	}
+	return listEdges(request, edges, edgeTail)
+}
+
+func (s *LanternService) ScanVertices(ctx context.Context, request *ScanVerticesRequest) (*ScanVerticesResponse, error) {
+	if err := validateScanVerticesRequest(request); err != nil {
+		return nil, err
+	}
+
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var after string
+	if request.PageToken != "" {
+		after, _ = decodeVertexCursor(request.PageToken)
+	}
+	page := newVertexPage(request)
+	n.cache.RangeVertices(func(v graph.Vertex[string, *Vertex]) bool {
+		if v.Key <= after || !matchVertex(request, v.Key) {
+			return true
+		}
+		// In a cluster, a vertex may be stored as the head of an edge in a node other than its owner.
+		if s.cluster != nil && !s.cluster.Owns(v.Key) {
+			return true
+		}
+		page.add(v)
+		return true
+	})
+	vertices := make([]*Vertex, 0, len(page.vertices))
+	for _, v := range page.vertices {
+		vertices = append(vertices, scannedVertex(request, v))
+	}
+
+	var more bool
+	if s.cluster != nil {
+		remote, m, err := s.cluster.ScanVertices(ctx, request)
+		if err != nil {
+			return nil, clusterError(err)
+		}
+		vertices = append(vertices, remote...)
+		more = m
+	}
+	return scanVertices(request, vertices, more), nil
+}
+
+func (s *LanternService) Stats(ctx context.Context, request *StatsRequest) (*StatsResponse, error) {
+	if request.Top > maxTop {
+		return nil, status.Errorf(codes.InvalidArgument, "top must not be greater than %d", maxTop)
+	}
+	top := int(request.Top)
+	if top == 0 {
+		top = defaultTop
+	}
+
+	n, err := s.namespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := statsOptions
+	options.Top = top
+	// In a cluster, a vertex may be stored as the head of an edge in a node other than its owner.
+	if s.cluster != nil {
+		options.Owns = s.cluster.Owns
+	}
+	response := statsResponse(n.cache.Stats(options))
+	if s.cluster != nil {
+		remote, err := s.cluster.Stats(ctx, &StatsRequest{Top: uint32(top)})
+		if err != nil {
+			return nil, clusterError(err)
+		}
+		if len(remote) > 0 {
+			response = mergeStats(append(remote, response), top)
+		}
+	}
+	sortDegrees(response.TopDegrees)
+	return response, nil
+}
+
 func (s *LanternService) AddEdge(ctx context.Context, request *AddEdgeRequest) (*AddEdgeResponse, error) {
-	log.Printf("PutEdge: %v", request)
-	for _, e := range request.Edges {
-		s.cache.AddEdgeWithExpiration(e.Tail, e.Head, e.Weight, e.Expiration.AsTime())
+	if err := validateEdges(request.Edges); err != nil {
+		return nil, err
+	}
+	if err := s.write(ctx, request); err != nil {
+		return nil, err
 	}
 	return &AddEdgeResponse{Status: Status_STATUS_OK}, nil
 }
 
 func (s *LanternService) PutEdge(ctx context.Context, request *PutEdgeRequest) (*PutEdgeResponse, error) {
-	log.Printf("PutEdge: %v", request)
-	for _, e := range request.Edges {
-		s.cache.DeleteEdge(e.Tail, e.Head)
-		s.cache.AddEdgeWithExpiration(e.Tail, e.Head, e.Weight, e.Expiration.AsTime())
+	if err := validateEdges(request.Edges); err != nil {
+		return nil, err
+	}
+	if err := s.write(ctx, request); err != nil {
+		return nil, err
 	}
 	return &PutEdgeResponse{Status: Status_STATUS_OK}, nil
 }
 
+// Watch streams events of mutations and expirations. In a cluster, only events of the
+// vertices and edges stored in this node are streamed.
+func (s *LanternService) Watch(request *WatchRequest, stream LanternService_WatchServer) error {
+	n, err := s.namespace(stream.Context())
+	if err != nil {
+		return err
+	}
+	w := n.watchers.subscribe(request.Prefix)
+	defer n.watchers.unsubscribe(w)
+
+	for {
+		select {
+		case e, ok := <-w.c:
+			if !ok {
+				switch w.err {
+				case errSlowWatcher:
+					return status.Error(codes.ResourceExhausted, w.err.Error())
+				case errNamespaceDropped:
+					return namespace.NotFound(w.err.Error())
+				}
+				return status.Error(codes.Unavailable, w.err.Error())
+			}
+			if err := stream.Send(&WatchResponse{Event: e}); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Ingest writes batches of vertices and edges streamed by a client, each as a single mutation,
+// and acknowledges each of them. A batch which is invalid or fails to be written is rejected in
+// its acknowledgement without ending the stream.
+func (s *LanternService) Ingest(stream LanternService_IngestServer) error {
+	ctx := stream.Context()
+	for {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		response := &IngestResponse{
+			Id:     request.Id,
+			Status: Status_STATUS_OK,
+		}
+		err = validateVertices(request.Vertices)
+		if err == nil {
+			err = validateEdges(request.Edges)
+		}
+		if err == nil && (len(request.Vertices) > 0 || len(request.Edges) > 0) {
+			err = s.write(ctx, request)
+		}
+		if err != nil {
+			response.Status = ingestStatus(err)
+			response.Message = status.Convert(err).Message()
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// ingestStatus returns the status of a batch which has failed with err.
+func ingestStatus(err error) Status {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return Status_STATUS_INVALID_REQUEST
+	case codes.ResourceExhausted:
+		return Status_STATUS_RESOURCE_EXHAUSTED
+	default:
+		return Status_STATUS_INTERNAL_SERVER_ERROR
+	}
+}
+
 type LanternServer struct {
-	service  *LanternService
-	server   *grpc.Server
-	listener net.Listener
+	service     *LanternService
+	leader      *replication.Leader
+	server      *grpc.Server
+	listener    net.Listener
+	snapshotter *storage.Snapshotter
+	sweeper     *graph.Sweeper[string, *Vertex]
+	metrics     *http.Server
+	tracer      *sdktrace.TracerProvider
+	certs       *certs.Reloader
 }
 
 func (s *LanternService) DeleteEdge(ctx context.Context, in *DeleteEdgeRequest) (*DeleteEdgeResponse, error) {
-	log.Printf("DeleteEdge: %v", in)
-	s.cache.DeleteEdge(in.Tail, in.Head)
-	return &DeleteEdgeResponse{}, nil
+	if err := validateEdgeKey(in.Tail, in.Head); err != nil {
+		return nil, err
+	}
+	if err := s.write(ctx, in); err != nil {
+		return nil, err
+	}
+	return &DeleteEdgeResponse{Status: Status_STATUS_OK}, nil
 }
 
-func NewLanternServer(service *LanternService, server *grpc.Server, listener net.Listener) *LanternServer {
+// Touch extends expirations of vertices and edges to the expiration of the request, or to the
+// default TTL of the namespace from now, without rewriting their values.
+func (s *LanternService) Touch(ctx context.Context, request *TouchRequest) (*TouchResponse, error) {
+	for _, key := range request.Keys {
+		if key == "" {
+			return nil, status.Error(codes.InvalidArgument, "key must not be empty")
+		}
+	}
+	for _, e := range request.Edges {
+		if err := validateEdgeKey(e.Tail, e.Head); err != nil {
+			return nil, err
+		}
+	}
+	if request.Expiration == nil {
+		n, err := s.namespace(ctx)
+		if err != nil {
+			return nil, err
+		}
+		request = proto.Clone(request).(*TouchRequest)
+		request.Expiration = timestamppb.New(time.Now().Add(n.defaultTTL))
+	}
+	if err := s.write(ctx, request); err != nil {
+		return nil, err
+	}
+	return &TouchResponse{Status: Status_STATUS_OK}, nil
+}
+
+func NewLanternServer(service *LanternService, leader *replication.Leader, server *grpc.Server, listener net.Listener, snapshotter *storage.Snapshotter, sweeper *graph.Sweeper[string, *Vertex], metrics *http.Server, tracer *sdktrace.TracerProvider, reloader *certs.Reloader) *LanternServer {
 	return &LanternServer{
-		service:  service,
-		server:   server,
-		listener: listener,
+		service:     service,
+		leader:      leader,
+		server:      server,
+		listener:    listener,
+		snapshotter: snapshotter,
+		sweeper:     sweeper,
+		metrics:     metrics,
+		tracer:      tracer,
+		certs:       reloader,
 	}
 }
 
 func (s *LanternServer) Run(ctx context.Context) error {
 	go func() {
 		<-ctx.Done()
-		log.Println("Shutting down server")
+		slog.Info("Shutting down server")
+		s.leader.Close()
+		s.service.namespaces.close(errShuttingDown)
 		s.server.GracefulStop()
+		s.metrics.Close()
 	}()
 
-	go s.service.cache.Watch(ctx, 1*time.Minute)
+	go s.sweeper.Watch(ctx)
+	if s.certs != nil {
+		go s.certs.Watch(ctx)
+	}
+	go func() {
+		slog.Info("Serving metrics", "addr", s.metrics.Addr)
+		if err := s.metrics.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Error("Failed to serve metrics", "error", err)
+		}
+	}()
+	go s.snapshotter.Watch(ctx)
+	go s.service.wal.Watch(ctx)
 
 	RegisterLanternServiceServer(s.server, s.service)
-	return s.server.Serve(s.listener)
+	if s.service.follower != nil {
+		slog.Info("Following leader", "leader", s.service.follower.Leader())
+		go s.service.follower.Run(ctx)
+	} else {
+		RegisterReplicationServiceServer(s.server, s.leader)
+		go s.service.namespaces.feed(ctx, s.service.wal)
+	}
+	if s.service.cluster != nil {
+		RegisterClusterServiceServer(s.server, s.service.cluster)
+	}
+
+	if err := s.server.Serve(s.listener); err != nil {
+		return err
+	}
+	if s.service.cluster != nil {
+		if err := s.service.cluster.Close(); err != nil {
+			slog.Error("Failed to close connections to other nodes", "error", err)
+		}
+	}
+
+	slog.Info("Saving snapshot")
+	if err := s.snapshotter.Save(); err != nil {
+		return err
+	}
+	if s.service.sink != nil {
+		if err := s.service.sink.Close(); err != nil {
+			return err
+		}
+	}
+	if err := s.tracer.Shutdown(context.Background()); err != nil {
+		slog.Error("Failed to flush spans", "error", err)
+	}
+	return s.service.wal.Close()
 }
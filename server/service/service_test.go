@@ -0,0 +1,992 @@
+package service
+
+import (
+	"context"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/storage"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"net"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func newTestService(t *testing.T) *LanternService {
+	t.Helper()
+	cache := graph.NewGraphCache[string, *Vertex](time.Minute)
+	namespaces := NewNamespaces(cache, time.Minute, time.Second)
+	t.Cleanup(func() { namespaces.close(errShuttingDown) })
+	wal, err := storage.OpenWAL("", storage.SyncNever, namespaces)
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	t.Cleanup(func() { wal.Close() })
+	return NewLanternService(namespaces, wal, nil, nil, nil)
+}
+
+// startTestServer serves s over a loopback connection, and returns a client of it.
+func startTestServer(t *testing.T, s *LanternService) LanternServiceClient {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	server := grpc.NewServer()
+	RegisterLanternServiceServer(server, s)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.Dial() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewLanternServiceClient(conn)
+}
+
+func edgeSet(g *Graph) map[string]float32 {
+	edges := make(map[string]float32)
+	for _, e := range g.Edges {
+		edges[e.Tail+"->"+e.Head] = e.Weight
+	}
+	return edges
+}
+
+func TestLanternService_Illuminate(t *testing.T) {
+	s := newTestService(t)
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := s.AddEdge(context.Background(), &AddEdgeRequest{Edges: []*Edge{
+		{Tail: "a", Head: "b", Weight: 3, Expiration: expiration},
+		{Tail: "a", Head: "c", Weight: 2, Expiration: expiration},
+		{Tail: "a", Head: "d", Weight: 1, Expiration: expiration},
+		{Tail: "b", Head: "e", Weight: 5, Expiration: expiration},
+		{Tail: "b", Head: "f", Weight: 1, Expiration: expiration},
+		{Tail: "c", Head: "g", Weight: 4, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	// The graph is a tree rooted at the seed, so every optimization keeps all explored edges.
+	tests := []struct {
+		name string
+		step uint32
+		k    uint32
+		want map[string]float32
+	}{
+		{
+			name: "step 1, k 1",
+			step: 1,
+			k:    1,
+			want: map[string]float32{"a->b": 3},
+		},
+		{
+			name: "step 1, k 3",
+			step: 1,
+			k:    3,
+			want: map[string]float32{"a->b": 3, "a->c": 2, "a->d": 1},
+		},
+		{
+			name: "step 2, k 1",
+			step: 2,
+			k:    1,
+			want: map[string]float32{"a->b": 3, "b->e": 5},
+		},
+		{
+			name: "step 2, k 2",
+			step: 2,
+			k:    2,
+			want: map[string]float32{"a->b": 3, "a->c": 2, "b->e": 5, "b->f": 1, "c->g": 4},
+		},
+		{
+			name: "step 3, k 10",
+			step: 3,
+			k:    10,
+			want: map[string]float32{"a->b": 3, "a->c": 2, "a->d": 1, "b->e": 5, "b->f": 1, "c->g": 4},
+		},
+	}
+	for _, tt := range tests {
+		for o := range Optimization_name {
+			optimization := Optimization(o)
+			t.Run(tt.name+", "+optimization.String(), func(t *testing.T) {
+				got, err := s.Illuminate(context.Background(), &IlluminateRequest{
+					Seed:         "a",
+					Step:         tt.step,
+					K:            tt.k,
+					Optimization: optimization,
+				})
+				if err != nil {
+					t.Fatalf("Illuminate() error = %v", err)
+				}
+				if edges := edgeSet(got.Graph); !reflect.DeepEqual(edges, tt.want) {
+					t.Errorf("Illuminate() edges = %v, want %v", edges, tt.want)
+				}
+				if len(got.Graph.Vertices) != len(tt.want)+1 {
+					t.Errorf("Illuminate() returns %d vertices, want %d", len(got.Graph.Vertices), len(tt.want)+1)
+				}
+			})
+		}
+	}
+}
+
+func TestLanternService_Illuminate_Optimization(t *testing.T) {
+	s := newTestService(t)
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := s.AddEdge(context.Background(), &AddEdgeRequest{Edges: []*Edge{
+		{Tail: "a", Head: "b", Weight: 1, Expiration: expiration},
+		{Tail: "a", Head: "c", Weight: 4, Expiration: expiration},
+		{Tail: "b", Head: "c", Weight: 2, Expiration: expiration},
+		{Tail: "b", Head: "d", Weight: 4, Expiration: expiration},
+		{Tail: "c", Head: "d", Weight: 3, Expiration: expiration},
+		{Tail: "d", Head: "a", Weight: 5, Expiration: expiration},
+		{Tail: "d", Head: "b", Weight: 2, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	// Every vertex is reached along several paths, so each optimization keeps its own tree.
+	tests := []struct {
+		optimization Optimization
+		want         map[string]float32
+	}{
+		{
+			optimization: Optimization_OPTIMIZATION_UNSPECIFIED,
+			want:         map[string]float32{"a->b": 1, "a->c": 4, "b->c": 2, "b->d": 4, "c->d": 3, "d->a": 5, "d->b": 2},
+		},
+		{
+			optimization: Optimization_OPTIMIZATION_MINIMUM_SPANNING_TREE,
+			want:         map[string]float32{"a->b": 1, "b->c": 2, "c->d": 3},
+		},
+		{
+			optimization: Optimization_OPTIMIZATION_MAXIMUM_SPANNING_TREE,
+			want:         map[string]float32{"a->c": 4, "c->d": 3, "d->b": 2},
+		},
+		{
+			// b, c and d are the closest at 1, 3 and 5.
+			optimization: Optimization_OPTIMIZATION_SHORTEST_PATH_TREE,
+			want:         map[string]float32{"a->b": 1, "b->c": 2, "b->d": 4},
+		},
+		{
+			// c, b and d are the closest at 1/4, 1 and 1/4+1/3 by the inverse of weights.
+			optimization: Optimization_OPTIMIZATION_SHORTEST_PATH_TREE_INVERSE,
+			want:         map[string]float32{"a->b": 1, "a->c": 4, "c->d": 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.optimization.String(), func(t *testing.T) {
+			got, err := s.Illuminate(context.Background(), &IlluminateRequest{
+				Seed:         "a",
+				Step:         3,
+				K:            10,
+				Optimization: tt.optimization,
+			})
+			if err != nil {
+				t.Fatalf("Illuminate() error = %v", err)
+			}
+			if edges := edgeSet(got.Graph); !reflect.DeepEqual(edges, tt.want) {
+				t.Errorf("Illuminate() edges = %v, want %v", edges, tt.want)
+			}
+			if len(got.Graph.Vertices) != 4 {
+				t.Errorf("Illuminate() returns %d vertices, want 4", len(got.Graph.Vertices))
+			}
+		})
+	}
+}
+
+func TestLanternService_Illuminate_Direction(t *testing.T) {
+	s := newTestService(t)
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := s.AddEdge(context.Background(), &AddEdgeRequest{Edges: []*Edge{
+		{Tail: "b", Head: "a", Weight: 3, Expiration: expiration},
+		{Tail: "c", Head: "a", Weight: 2, Expiration: expiration},
+		{Tail: "d", Head: "b", Weight: 4, Expiration: expiration},
+		{Tail: "a", Head: "e", Weight: 1, Expiration: expiration},
+		{Tail: "e", Head: "f", Weight: 5, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	// The graph is a tree if directions are ignored, so every optimization keeps all explored edges
+	// in their own direction.
+	tests := []struct {
+		name      string
+		direction Direction
+		step      uint32
+		want      map[string]float32
+	}{
+		{
+			name:      "unspecified",
+			direction: Direction_DIRECTION_UNSPECIFIED,
+			step:      2,
+			want:      map[string]float32{"a->e": 1, "e->f": 5},
+		},
+		{
+			name:      "outbound",
+			direction: Direction_DIRECTION_OUTBOUND,
+			step:      2,
+			want:      map[string]float32{"a->e": 1, "e->f": 5},
+		},
+		{
+			name:      "inbound",
+			direction: Direction_DIRECTION_INBOUND,
+			step:      2,
+			want:      map[string]float32{"b->a": 3, "c->a": 2, "d->b": 4},
+		},
+		{
+			name:      "both, step 1",
+			direction: Direction_DIRECTION_BOTH,
+			step:      1,
+			want:      map[string]float32{"b->a": 3, "c->a": 2, "a->e": 1},
+		},
+		{
+			name:      "both, step 2",
+			direction: Direction_DIRECTION_BOTH,
+			step:      2,
+			want:      map[string]float32{"b->a": 3, "c->a": 2, "a->e": 1, "d->b": 4, "e->f": 5},
+		},
+	}
+	for _, tt := range tests {
+		for o := range Optimization_name {
+			optimization := Optimization(o)
+			t.Run(tt.name+", "+optimization.String(), func(t *testing.T) {
+				got, err := s.Illuminate(context.Background(), &IlluminateRequest{
+					Seed:         "a",
+					Step:         tt.step,
+					K:            10,
+					Optimization: optimization,
+					Direction:    tt.direction,
+				})
+				if err != nil {
+					t.Fatalf("Illuminate() error = %v", err)
+				}
+				if edges := edgeSet(got.Graph); !reflect.DeepEqual(edges, tt.want) {
+					t.Errorf("Illuminate() edges = %v, want %v", edges, tt.want)
+				}
+				if len(got.Graph.Vertices) != len(tt.want)+1 {
+					t.Errorf("Illuminate() returns %d vertices, want %d", len(got.Graph.Vertices), len(tt.want)+1)
+				}
+			})
+		}
+	}
+}
+
+func TestLanternService_Illuminate_Direction_Optimization(t *testing.T) {
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	edges := []*Edge{
+		{Tail: "a", Head: "b", Weight: 7, Expiration: expiration},
+		{Tail: "a", Head: "c", Weight: 4, Expiration: expiration},
+		{Tail: "b", Head: "c", Weight: 1, Expiration: expiration},
+		{Tail: "b", Head: "d", Weight: 8, Expiration: expiration},
+		{Tail: "c", Head: "d", Weight: 9, Expiration: expiration},
+		{Tail: "d", Head: "a", Weight: 6, Expiration: expiration},
+		{Tail: "d", Head: "b", Weight: 2, Expiration: expiration},
+	}
+	all := edgeSet(&Graph{Edges: edges})
+
+	// Every vertex has edges to and from others, so trees depend on the direction they are
+	// computed in, and are returned with the edges in their own direction.
+	tests := []struct {
+		direction    Direction
+		optimization Optimization
+		want         map[string]float32
+	}{
+		{Direction_DIRECTION_OUTBOUND, Optimization_OPTIMIZATION_UNSPECIFIED, all},
+		{Direction_DIRECTION_OUTBOUND, Optimization_OPTIMIZATION_MINIMUM_SPANNING_TREE, map[string]float32{"a->b": 7, "a->c": 4, "b->d": 8}},
+		{Direction_DIRECTION_OUTBOUND, Optimization_OPTIMIZATION_SHORTEST_PATH_TREE, map[string]float32{"a->b": 7, "a->c": 4, "c->d": 9}},
+		{Direction_DIRECTION_INBOUND, Optimization_OPTIMIZATION_UNSPECIFIED, all},
+		{Direction_DIRECTION_INBOUND, Optimization_OPTIMIZATION_MINIMUM_SPANNING_TREE, map[string]float32{"b->d": 8, "c->d": 9, "d->a": 6}},
+		{Direction_DIRECTION_INBOUND, Optimization_OPTIMIZATION_MAXIMUM_SPANNING_TREE, map[string]float32{"b->d": 8, "c->d": 9, "d->a": 6}},
+		{Direction_DIRECTION_INBOUND, Optimization_OPTIMIZATION_SHORTEST_PATH_TREE, map[string]float32{"b->d": 8, "c->d": 9, "d->a": 6}},
+		{Direction_DIRECTION_BOTH, Optimization_OPTIMIZATION_UNSPECIFIED, all},
+		{Direction_DIRECTION_BOTH, Optimization_OPTIMIZATION_MINIMUM_SPANNING_TREE, map[string]float32{"a->c": 4, "b->c": 1, "d->a": 6}},
+		{Direction_DIRECTION_BOTH, Optimization_OPTIMIZATION_MAXIMUM_SPANNING_TREE, map[string]float32{"a->b": 7, "b->d": 8, "c->d": 9}},
+		{Direction_DIRECTION_BOTH, Optimization_OPTIMIZATION_SHORTEST_PATH_TREE, map[string]float32{"a->c": 4, "b->c": 1, "d->a": 6}},
+		{Direction_DIRECTION_BOTH, Optimization_OPTIMIZATION_SHORTEST_PATH_TREE_INVERSE, map[string]float32{"a->b": 7, "a->c": 4, "d->a": 6}},
+	}
+	nodes := map[string]*LanternService{
+		"single node": newTestService(t),
+		"cluster":     startClusterServices(t, 3)[0],
+	}
+	for name, s := range nodes {
+		if _, err := s.AddEdge(context.Background(), &AddEdgeRequest{Edges: edges}); err != nil {
+			t.Fatalf("%s: AddEdge() error = %v", name, err)
+		}
+		for _, tt := range tests {
+			t.Run(name+", "+tt.direction.String()+", "+tt.optimization.String(), func(t *testing.T) {
+				got, err := s.Illuminate(context.Background(), &IlluminateRequest{
+					Seed:         "a",
+					Step:         3,
+					K:            10,
+					Optimization: tt.optimization,
+					Direction:    tt.direction,
+				})
+				if err != nil {
+					t.Fatalf("Illuminate() error = %v", err)
+				}
+				if edges := edgeSet(got.Graph); !reflect.DeepEqual(edges, tt.want) {
+					t.Errorf("Illuminate() edges = %v, want %v", edges, tt.want)
+				}
+				if len(got.Graph.Vertices) != 4 {
+					t.Errorf("Illuminate() returns %d vertices, want 4", len(got.Graph.Vertices))
+				}
+			})
+		}
+	}
+}
+
+func TestLanternService_Illuminate_InvalidArgument(t *testing.T) {
+	s := newTestService(t)
+	tests := []struct {
+		name    string
+		request *IlluminateRequest
+	}{
+		{name: "empty seed", request: &IlluminateRequest{Step: 1, K: 1}},
+		{name: "zero step", request: &IlluminateRequest{Seed: "a", K: 1}},
+		{name: "zero k", request: &IlluminateRequest{Seed: "a", Step: 1}},
+		{name: "unknown optimization", request: &IlluminateRequest{Seed: "a", Step: 1, K: 1, Optimization: 99}},
+		{name: "unknown direction", request: &IlluminateRequest{Seed: "a", Step: 1, K: 1, Direction: 99}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.Illuminate(context.Background(), tt.request)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Illuminate() error = %v, want code %v", err, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestLanternService_Codes(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: []*Edge{{Tail: "a", Head: "b", Weight: 1, Expiration: expiration}}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{
+			name: "GetVertex",
+			call: func() error { _, err := s.GetVertex(ctx, &GetVertexRequest{Key: "a"}); return err },
+			want: codes.OK,
+		},
+		{
+			name: "GetVertex of missing vertex",
+			call: func() error { _, err := s.GetVertex(ctx, &GetVertexRequest{Key: "x"}); return err },
+			want: codes.NotFound,
+		},
+		{
+			name: "GetVertex with empty key",
+			call: func() error { _, err := s.GetVertex(ctx, &GetVertexRequest{}); return err },
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "GetEdge",
+			call: func() error { _, err := s.GetEdge(ctx, &GetEdgeRequest{Tail: "a", Head: "b"}); return err },
+			want: codes.OK,
+		},
+		{
+			name: "GetEdge of missing edge",
+			call: func() error { _, err := s.GetEdge(ctx, &GetEdgeRequest{Tail: "b", Head: "a"}); return err },
+			want: codes.NotFound,
+		},
+		{
+			name: "GetEdge with empty head",
+			call: func() error { _, err := s.GetEdge(ctx, &GetEdgeRequest{Tail: "a"}); return err },
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "PutVertex with empty key",
+			call: func() error {
+				_, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{{Expiration: expiration}}})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "DeleteVertex with empty key",
+			call: func() error { _, err := s.DeleteVertex(ctx, &DeleteVertexRequest{}); return err },
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "AddEdge with empty tail",
+			call: func() error {
+				_, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: []*Edge{{Head: "b", Weight: 1, Expiration: expiration}}})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "PutEdge with infinite weight",
+			call: func() error {
+				_, err := s.PutEdge(ctx, &PutEdgeRequest{Edges: []*Edge{{Tail: "a", Head: "b", Weight: float32(math.Inf(1)), Expiration: expiration}}})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "DeleteEdge with empty tail",
+			call: func() error { _, err := s.DeleteEdge(ctx, &DeleteEdgeRequest{Head: "b"}); return err },
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "Illuminate from missing seed",
+			call: func() error {
+				_, err := s.Illuminate(ctx, &IlluminateRequest{Seed: "x", Step: 1, K: 1})
+				return err
+			},
+			want: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.call()); code != tt.want {
+				t.Errorf("status.Code() = %v, want %v", code, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanternService_Ingest(t *testing.T) {
+	s := newTestService(t)
+	stream, err := startTestServer(t, s).Ingest(context.Background())
+	if err != nil {
+		t.Fatalf("Ingest() error = %v", err)
+	}
+
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	requests := []*IngestRequest{
+		{
+			Id:       1,
+			Vertices: []*Vertex{{Key: "a", Value: &Vertex_Int64{Int64: 1}, Expiration: expiration}},
+			Edges:    []*Edge{{Tail: "a", Head: "b", Weight: 1, Expiration: expiration}},
+		},
+		{
+			Id:       2,
+			Vertices: []*Vertex{{Key: "c", Expiration: expiration}},
+			Edges:    []*Edge{{Tail: "c", Weight: 1, Expiration: expiration}},
+		},
+		{
+			Id:    3,
+			Edges: []*Edge{{Tail: "a", Head: "b", Weight: 2, Expiration: expiration}},
+		},
+	}
+	want := []Status{Status_STATUS_OK, Status_STATUS_INVALID_REQUEST, Status_STATUS_OK}
+	for _, r := range requests {
+		if err := stream.Send(r); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v", err)
+	}
+	for i, r := range requests {
+		response, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if response.Id != r.Id || response.Status != want[i] {
+			t.Errorf("Recv() = %d %v, want %d %v", response.Id, response.Status, r.Id, want[i])
+		}
+	}
+
+	if w, ok := s.cache.GetWeight("a", "b"); !ok || w != 3 {
+		t.Errorf("GetWeight(a, b) = %v, %v, want 3, true", w, ok)
+	}
+	if _, ok := s.cache.GetVertex("c"); ok {
+		t.Errorf("vertex of an invalid batch is written")
+	}
+}
+
+func TestLanternService_Ingest_ResourceExhausted(t *testing.T) {
+	s := newTestService(t)
+	s.cache.SetLimits(graph.Limits[string, *Vertex]{MaxVertices: 2})
+	stream, err := startTestServer(t, s).Ingest(context.Background())
+	if err != nil {
+		t.Fatalf("Ingest() error = %v", err)
+	}
+
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	requests := []*IngestRequest{
+		// The vertex fits, but the edge to c does not, so neither of them is written.
+		{
+			Id:       1,
+			Vertices: []*Vertex{{Key: "a", Expiration: expiration}},
+			Edges:    []*Edge{{Tail: "b", Head: "c", Weight: 1, Expiration: expiration}},
+		},
+		{
+			Id:    2,
+			Edges: []*Edge{{Tail: "a", Head: "b", Weight: 1, Expiration: expiration}},
+		},
+	}
+	want := []Status{Status_STATUS_RESOURCE_EXHAUSTED, Status_STATUS_OK}
+	for i, r := range requests {
+		if err := stream.Send(r); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		response, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if response.Id != r.Id || response.Status != want[i] {
+			t.Errorf("Recv() = %d %v, want %d %v", response.Id, response.Status, r.Id, want[i])
+		}
+	}
+	if vertices, edges := s.cache.Size(); vertices != 2 || edges != 1 {
+		t.Errorf("Size() = %d vertices and %d edges, want 2 and 1", vertices, edges)
+	}
+}
+
+func TestLanternService_GetVertices(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{
+		{Key: "a", Value: &Vertex_Int64{Int64: 1}, Expiration: expiration},
+		{Key: "b", Value: &Vertex_Nil{Nil: true}, Expiration: expiration},
+		{Key: "c", Value: &Vertex_Int64{Int64: 3}, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		keys        []string
+		wantFound   []string
+		wantMissing []string
+		wantCode    codes.Code
+	}{
+		{name: "all found", keys: []string{"c", "a", "b"}, wantFound: []string{"c", "a", "b"}},
+		{name: "some missing", keys: []string{"x", "a", "y"}, wantFound: []string{"a"}, wantMissing: []string{"x", "y"}},
+		{name: "duplicated keys", keys: []string{"a", "x", "a", "x"}, wantFound: []string{"a"}, wantMissing: []string{"x"}},
+		{name: "no keys"},
+		{name: "empty key", keys: []string{"a", ""}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.GetVertices(ctx, &GetVerticesRequest{Keys: tt.keys})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetVertices() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			var found []string
+			for _, v := range got.Vertices {
+				found = append(found, v.Key)
+			}
+			if !reflect.DeepEqual(found, tt.wantFound) {
+				t.Errorf("GetVertices() vertices = %v, want %v", found, tt.wantFound)
+			}
+			if !reflect.DeepEqual(got.Missing, tt.wantMissing) {
+				t.Errorf("GetVertices() missing = %v, want %v", got.Missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestLanternService_ListEdges(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	var edges []*Edge
+	for _, e := range []struct {
+		tail, head string
+		weight     float32
+	}{
+		{"a", "b", 3}, {"a", "c", 1}, {"a", "d", 2}, {"a", "e", 2}, {"a", "f", 5},
+		{"b", "f", 1}, {"c", "f", 4},
+	} {
+		edges = append(edges, &Edge{Tail: e.tail, Head: e.head, Weight: e.weight, Expiration: expiration})
+	}
+	if _, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: edges}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+	weight := func(w float32) *float32 { return &w }
+
+	tests := []struct {
+		name    string
+		list    func(context.Context, *ListEdgesRequest) (*ListEdgesResponse, error)
+		request *ListEdgesRequest
+		want    [][]string
+	}{
+		{
+			name:    "out edges in descending order",
+			list:    s.ListOutEdges,
+			request: &ListEdgesRequest{Key: "a", PageSize: 2},
+			want:    [][]string{{"a->f", "a->b"}, {"a->d", "a->e"}, {"a->c"}},
+		},
+		{
+			name:    "out edges in ascending order",
+			list:    s.ListOutEdges,
+			request: &ListEdgesRequest{Key: "a", PageSize: 3, Order: EdgeOrder_EDGE_ORDER_WEIGHT_ASCENDING},
+			want:    [][]string{{"a->c", "a->d", "a->e"}, {"a->b", "a->f"}},
+		},
+		{
+			name:    "out edges within weights",
+			list:    s.ListOutEdges,
+			request: &ListEdgesRequest{Key: "a", MinWeight: weight(2), MaxWeight: weight(3)},
+			want:    [][]string{{"a->b", "a->d", "a->e"}},
+		},
+		{
+			name:    "in edges",
+			list:    s.ListInEdges,
+			request: &ListEdgesRequest{Key: "f", PageSize: 2},
+			want:    [][]string{{"a->f", "c->f"}, {"b->f"}},
+		},
+		{
+			name:    "no edges",
+			list:    s.ListInEdges,
+			request: &ListEdgesRequest{Key: "a"},
+			want:    [][]string{nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]string
+			request := tt.request
+			for {
+				response, err := tt.list(ctx, request)
+				if err != nil {
+					t.Fatalf("list error = %v", err)
+				}
+				var page []string
+				for _, e := range response.Edges {
+					page = append(page, e.Tail+"->"+e.Head)
+				}
+				got = append(got, page)
+				if response.NextPageToken == "" {
+					break
+				}
+				request = proto.Clone(request).(*ListEdgesRequest)
+				request.PageToken = response.NextPageToken
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanternService_ListEdges_InvalidArgument(t *testing.T) {
+	s := newTestService(t)
+	tests := []struct {
+		name    string
+		request *ListEdgesRequest
+	}{
+		{name: "empty key", request: &ListEdgesRequest{}},
+		{name: "too large page", request: &ListEdgesRequest{Key: "a", PageSize: maxPageSize + 1}},
+		{name: "invalid token", request: &ListEdgesRequest{Key: "a", PageToken: "!"}},
+		{name: "token of another order", request: &ListEdgesRequest{
+			Key:       "a",
+			PageToken: edgeCursor{order: EdgeOrder_EDGE_ORDER_WEIGHT_ASCENDING}.encode(),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.ListOutEdges(context.Background(), tt.request); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("ListOutEdges() error = %v, want code %v", err, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestLanternService_ScanVertices(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	var vertices []*Vertex
+	for _, key := range []string{"item:1", "user:1", "user:2", "user:2:profile", "user:3", "user:3:profile"} {
+		vertices = append(vertices, &Vertex{Key: key, Value: &Vertex_String_{String_: key}, Expiration: expiration})
+	}
+	if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: vertices}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		request *ScanVerticesRequest
+		want    [][]string
+	}{
+		{
+			name:    "all",
+			request: &ScanVerticesRequest{PageSize: 4},
+			want:    [][]string{{"item:1", "user:1", "user:2", "user:2:profile"}, {"user:3", "user:3:profile"}},
+		},
+		{
+			name:    "prefix",
+			request: &ScanVerticesRequest{Prefix: "user:", PageSize: 2},
+			want:    [][]string{{"user:1", "user:2"}, {"user:2:profile", "user:3"}, {"user:3:profile"}},
+		},
+		{
+			name:    "pattern",
+			request: &ScanVerticesRequest{Pattern: "user:*:profile"},
+			want:    [][]string{{"user:2:profile", "user:3:profile"}},
+		},
+		{
+			name:    "prefix and pattern",
+			request: &ScanVerticesRequest{Prefix: "user:3", Pattern: "*:profile"},
+			want:    [][]string{{"user:3:profile"}},
+		},
+		{
+			name:    "no vertices",
+			request: &ScanVerticesRequest{Prefix: "group:"},
+			want:    [][]string{nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]string
+			request := tt.request
+			for {
+				response, err := s.ScanVertices(ctx, request)
+				if err != nil {
+					t.Fatalf("ScanVertices() error = %v", err)
+				}
+				var page []string
+				for _, v := range response.Vertices {
+					page = append(page, v.Key)
+				}
+				got = append(got, page)
+				if response.NextPageToken == "" {
+					break
+				}
+				request = proto.Clone(request).(*ScanVerticesRequest)
+				request.PageToken = response.NextPageToken
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanternService_ScanVertices_Mutation(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	put := func(keys ...string) {
+		var vertices []*Vertex
+		for _, key := range keys {
+			vertices = append(vertices, &Vertex{Key: key, Value: &Vertex_Int64{Int64: 1}, Expiration: expiration})
+		}
+		if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: vertices}); err != nil {
+			t.Fatalf("PutVertex() error = %v", err)
+		}
+	}
+	put("b", "d", "f")
+
+	first, err := s.ScanVertices(ctx, &ScanVerticesRequest{PageSize: 2, IncludeValues: true, IncludeExpirations: true})
+	if err != nil {
+		t.Fatalf("ScanVertices() error = %v", err)
+	}
+	if v := first.Vertices[0]; v.GetInt64() != 1 || !v.Expiration.AsTime().Equal(expiration.AsTime()) {
+		t.Errorf("ScanVertices() = %v, want value and expiration", v)
+	}
+
+	// Vertices before the cursor do not shift the next page.
+	put("a", "c", "e")
+	if _, err := s.DeleteVertex(ctx, &DeleteVertexRequest{Key: "b"}); err != nil {
+		t.Fatalf("DeleteVertex() error = %v", err)
+	}
+	second, err := s.ScanVertices(ctx, &ScanVerticesRequest{PageSize: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatalf("ScanVertices() error = %v", err)
+	}
+	var got []string
+	for _, v := range second.Vertices {
+		got = append(got, v.Key)
+		if v.Value != nil || v.Expiration != nil {
+			t.Errorf("ScanVertices() = %v, want key only", v)
+		}
+	}
+	if want := []string{"e", "f"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("second page = %v, want %v", got, want)
+	}
+}
+
+func TestLanternService_ScanVertices_InvalidArgument(t *testing.T) {
+	s := newTestService(t)
+	tests := []struct {
+		name    string
+		request *ScanVerticesRequest
+	}{
+		{name: "too large page", request: &ScanVerticesRequest{PageSize: maxPageSize + 1}},
+		{name: "invalid pattern", request: &ScanVerticesRequest{Pattern: "user:["}},
+		{name: "invalid token", request: &ScanVerticesRequest{PageToken: "!"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.ScanVertices(context.Background(), tt.request); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("ScanVertices() error = %v, want code %v", err, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestLanternService_Stats(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{
+		{Key: "a", Value: &Vertex_String_{String_: "a"}, Expiration: expiration},
+		{Key: "n", Value: &Vertex_Nil{Nil: true}, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: []*Edge{
+		{Tail: "a", Head: "b", Weight: 1, Expiration: expiration},
+		{Tail: "a", Head: "c", Weight: 1, Expiration: expiration},
+		{Tail: "a", Head: "d", Weight: 1, Expiration: expiration},
+		{Tail: "b", Head: "c", Weight: 1, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	got, err := s.Stats(ctx, &StatsRequest{Top: 1})
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	// b, c and d are created by edges without values.
+	if got.Vertices != 5 || got.NilVertices != 4 || got.Edges != 4 {
+		t.Errorf("Stats() = %d vertices, %d nil vertices and %d edges, want 5, 4 and 4", got.Vertices, got.NilVertices, got.Edges)
+	}
+	var top []string
+	for _, d := range got.TopDegrees {
+		top = append(top, d.Key)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(top, want) {
+		t.Errorf("TopDegrees = %v, want %v", top, want)
+	}
+	if got.MemoryBytes == 0 {
+		t.Errorf("MemoryBytes = 0, want positive")
+	}
+
+	if _, err := s.Stats(ctx, &StatsRequest{Top: maxTop + 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Stats() error = %v, want code %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestMergeStats(t *testing.T) {
+	stats := []*StatsResponse{
+		{
+			Vertices:        2,
+			Edges:           1,
+			MemoryBytes:     100,
+			DegreeHistogram: []*HistogramBucket{{UpperBound: 1, Count: 2}},
+			TopDegrees:      []*VertexDegree{{Key: "a", OutDegree: 1}, {Key: "b", InDegree: 1}},
+		},
+		{
+			Vertices:        1,
+			Edges:           1,
+			MemoryBytes:     50,
+			DegreeHistogram: []*HistogramBucket{{UpperBound: 1, Count: 1}},
+			TopDegrees:      []*VertexDegree{{Key: "b", OutDegree: 1}},
+		},
+	}
+	got := mergeStats(stats, 1)
+	if got.Vertices != 3 || got.Edges != 2 || got.MemoryBytes != 150 || got.DegreeHistogram[0].Count != 3 {
+		t.Errorf("mergeStats() = %v", got)
+	}
+	if len(got.TopDegrees) != 1 || got.TopDegrees[0].Key != "b" || got.TopDegrees[0].OutDegree != 1 || got.TopDegrees[0].InDegree != 1 {
+		t.Errorf("TopDegrees = %v, want b with out and in degrees 1", got.TopDegrees)
+	}
+}
+
+func TestLanternService_Illuminate_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	s := newTestService(t)
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "Illuminate")
+	if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{{Key: "a", Expiration: timestamppb.New(time.Now().Add(time.Hour))}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.Illuminate(ctx, &IlluminateRequest{Seed: "a", Step: 1, K: 1, Optimization: Optimization_OPTIMIZATION_MINIMUM_SPANNING_TREE}); err != nil {
+		t.Fatalf("Illuminate() error = %v", err)
+	}
+	parent.End()
+
+	var got []string
+	for _, span := range recorder.Ended() {
+		if span.Parent().SpanID() == parent.SpanContext().SpanID() {
+			got = append(got, span.Name())
+		}
+	}
+	if want := []string{"neighbor", "optimize"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("child spans = %v, want %v", got, want)
+	}
+}
+
+func TestLanternService_Touch(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	now := time.Now()
+	expiration := timestamppb.New(now.Add(time.Minute))
+	if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{{Key: "a", Value: &Vertex_Int64{Int64: 1}, Expiration: expiration}}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: []*Edge{{Tail: "a", Head: "b", Weight: 2, Expiration: expiration}}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+
+	touched := timestamppb.New(now.Add(time.Hour))
+	request := &TouchRequest{Keys: []string{"a", "missing"}, Edges: []*Edge{{Tail: "a", Head: "b", Weight: 5}}, Expiration: touched}
+	if _, err := s.Touch(ctx, request); err != nil {
+		t.Fatalf("Touch() error = %v", err)
+	}
+	scan, err := s.ScanVertices(ctx, &ScanVerticesRequest{IncludeValues: true, IncludeExpirations: true})
+	if err != nil {
+		t.Fatalf("ScanVertices() error = %v", err)
+	}
+	if v := scan.Vertices[0]; v.Key != "a" || v.GetInt64() != 1 || !v.Expiration.AsTime().Equal(touched.AsTime()) {
+		t.Errorf("ScanVertices() a = %v, want value 1 expiring at %v", v, touched.AsTime())
+	}
+	edges, err := s.ListOutEdges(ctx, &ListEdgesRequest{Key: "a"})
+	if err != nil {
+		t.Fatalf("ListOutEdges() error = %v", err)
+	}
+	if e := edges.Edges[0]; e.Weight != 2 {
+		t.Errorf("ListOutEdges() a->b = %v, want weight 2", e)
+	}
+
+	// A missing expiration is the default TTL from now, which does not shorten an hour.
+	if _, err := s.Touch(ctx, &TouchRequest{Keys: []string{"a"}}); err != nil {
+		t.Fatalf("Touch() error = %v", err)
+	}
+	if _, err := s.Touch(ctx, &TouchRequest{Edges: []*Edge{{Tail: "a"}}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Touch() error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestVertexPage(t *testing.T) {
+	page := newVertexPage(&ScanVerticesRequest{PageSize: 2})
+	for _, key := range []string{"e", "b", "d", "a", "f", "c"} {
+		page.add(graph.Vertex[string, *Vertex]{Key: key})
+	}
+	var got []string
+	for _, v := range page.vertices {
+		got = append(got, v.Key)
+	}
+	sort.Strings(got)
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("vertexPage keeps %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"google.golang.org/protobuf/proto"
+	"sort"
+)
+
+const (
+	defaultTop = 10
+	maxTop     = 1000
+)
+
+// statsOptions tells GraphCache.Stats how to inspect vertices.
+var statsOptions = graph.StatsOptions[string, *Vertex]{
+	IsNil: func(v *Vertex) bool {
+		return v == nil || v.GetNil()
+	},
+	KeySize: func(key string) int {
+		return len(key)
+	},
+	ValueSize: func(v *Vertex) int {
+		if v == nil {
+			return 0
+		}
+		return proto.Size(v)
+	},
+}
+
+// SizedLimits returns limits which count bytes of keys and vertices like Stats.
+func SizedLimits(limits graph.Limits[string, *Vertex]) graph.Limits[string, *Vertex] {
+	limits.KeySize = statsOptions.KeySize
+	limits.ValueSize = statsOptions.ValueSize
+	return limits
+}
+
+func histogram(buckets []graph.Bucket) []*HistogramBucket {
+	h := make([]*HistogramBucket, 0, len(buckets))
+	for _, b := range buckets {
+		h = append(h, &HistogramBucket{UpperBound: b.UpperBound, Count: uint64(b.Count)})
+	}
+	return h
+}
+
+func statsResponse(stats graph.Stats[string]) *StatsResponse {
+	response := &StatsResponse{
+		Vertices:        uint64(stats.Vertices),
+		NilVertices:     uint64(stats.NilVertices),
+		Edges:           uint64(stats.Edges),
+		DegreeHistogram: histogram(stats.DegreeHistogram),
+		TtlHistogram:    histogram(stats.TTLHistogram),
+		MemoryBytes:     uint64(stats.MemoryBytes),
+	}
+	for _, d := range stats.TopDegrees {
+		response.TopDegrees = append(response.TopDegrees, &VertexDegree{
+			Key:       d.Key,
+			OutDegree: uint64(d.Out),
+			InDegree:  uint64(d.In),
+		})
+	}
+	return response
+}
+
+// mergeStats sums statistics of nodes of a cluster. Degrees of a vertex are summed over
+// the top vertices of each node, so a vertex whose edges are spread over nodes may be missed.
+func mergeStats(stats []*StatsResponse, top int) *StatsResponse {
+	merged := &StatsResponse{}
+	degrees := make(map[string]*VertexDegree)
+	add := func(sum []*HistogramBucket, h []*HistogramBucket) []*HistogramBucket {
+		for i, b := range h {
+			if i < len(sum) {
+				sum[i].Count += b.Count
+			} else {
+				sum = append(sum, &HistogramBucket{UpperBound: b.UpperBound, Count: b.Count})
+			}
+		}
+		return sum
+	}
+	for _, s := range stats {
+		merged.Vertices += s.Vertices
+		merged.NilVertices += s.NilVertices
+		merged.Edges += s.Edges
+		merged.MemoryBytes += s.MemoryBytes
+		merged.DegreeHistogram = add(merged.DegreeHistogram, s.DegreeHistogram)
+		merged.TtlHistogram = add(merged.TtlHistogram, s.TtlHistogram)
+		for _, d := range s.TopDegrees {
+			if _, ok := degrees[d.Key]; !ok {
+				degrees[d.Key] = &VertexDegree{Key: d.Key}
+			}
+			degrees[d.Key].OutDegree += d.OutDegree
+			degrees[d.Key].InDegree += d.InDegree
+		}
+	}
+
+	for _, d := range degrees {
+		merged.TopDegrees = append(merged.TopDegrees, d)
+	}
+	sortDegrees(merged.TopDegrees)
+	if len(merged.TopDegrees) > top {
+		merged.TopDegrees = merged.TopDegrees[:top]
+	}
+	return merged
+}
+
+// sortDegrees sorts vertices in descending order of their degrees, and then by their keys.
+func sortDegrees(degrees []*VertexDegree) {
+	sort.Slice(degrees, func(i, j int) bool {
+		di := degrees[i].OutDegree + degrees[i].InDegree
+		dj := degrees[j].OutDegree + degrees[j].InDegree
+		if di != dj {
+			return di > dj
+		}
+		return degrees[i].Key < degrees[j].Key
+	})
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"errors"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"sync"
+	"time"
+)
+
+// watchBufferSize is the number of events buffered for each watcher. A watcher which falls
+// further behind is disconnected.
+const watchBufferSize = 1024
+
+var (
+	errSlowWatcher      = errors.New("watcher is too slow to receive events")
+	errShuttingDown     = errors.New("server is shutting down")
+	errNamespaceDropped = errors.New("namespace is dropped")
+)
+
+// watcher receives events whose keys start with prefix.
+type watcher struct {
+	prefix string
+	c      chan *Event
+	err    error
+}
+
+func (w *watcher) match(e *Event) bool {
+	if w.prefix == "" {
+		return true
+	}
+	if e.Vertex != nil {
+		return strings.HasPrefix(e.Vertex.Key, w.prefix)
+	}
+	if e.Edge != nil {
+		return strings.HasPrefix(e.Edge.Tail, w.prefix) || strings.HasPrefix(e.Edge.Head, w.prefix)
+	}
+	return false
+}
+
+// watchers fans out events to all watchers without blocking the publisher.
+type watchers struct {
+	mu      sync.Mutex
+	members map[*watcher]struct{}
+	closed  bool
+}
+
+func newWatchers() *watchers {
+	return &watchers{
+		members: make(map[*watcher]struct{}),
+	}
+}
+
+func (ws *watchers) subscribe(prefix string) *watcher {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	w := &watcher{
+		prefix: prefix,
+		c:      make(chan *Event, watchBufferSize),
+	}
+	if ws.closed {
+		w.err = errShuttingDown
+		close(w.c)
+		return w
+	}
+	ws.members[w] = struct{}{}
+	return w
+}
+
+func (ws *watchers) unsubscribe(w *watcher) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if _, ok := ws.members[w]; ok {
+		delete(ws.members, w)
+		close(w.c)
+	}
+}
+
+func (ws *watchers) publish(events []*Event) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	for w := range ws.members {
+		for _, e := range events {
+			if !w.match(e) {
+				continue
+			}
+			select {
+			case w.c <- e:
+			default:
+				w.err = errSlowWatcher
+				delete(ws.members, w)
+				close(w.c)
+			}
+			if w.err != nil {
+				break
+			}
+		}
+	}
+}
+
+// close disconnects all watchers with err, since their streams never end by themselves.
+func (ws *watchers) close(err error) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	ws.closed = true
+	for w := range ws.members {
+		w.err = err
+		delete(ws.members, w)
+		close(w.c)
+	}
+}
+
+// mutationEvents returns events of a mutation request.
+func mutationEvents(m proto.Message, now time.Time) []*Event {
+	timestamp := timestamppb.New(now)
+	var events []*Event
+	switch r := m.(type) {
+	case *PutVertexRequest:
+		for _, v := range r.Vertices {
+			events = append(events, &Event{Type: EventType_EVENT_TYPE_PUT_VERTEX, Vertex: v, Timestamp: timestamp})
+		}
+
+	case *DeleteVertexRequest:
+		events = append(events, &Event{Type: EventType_EVENT_TYPE_DELETE_VERTEX, Vertex: &Vertex{Key: r.Key}, Timestamp: timestamp})
+
+	case *AddEdgeRequest:
+		for _, e := range r.Edges {
+			events = append(events, &Event{Type: EventType_EVENT_TYPE_ADD_EDGE, Edge: e, Timestamp: timestamp})
+		}
+
+	case *PutEdgeRequest:
+		for _, e := range r.Edges {
+			events = append(events, &Event{Type: EventType_EVENT_TYPE_PUT_EDGE, Edge: e, Timestamp: timestamp})
+		}
+
+	case *DeleteEdgeRequest:
+		events = append(events, &Event{Type: EventType_EVENT_TYPE_DELETE_EDGE, Edge: &Edge{Tail: r.Tail, Head: r.Head}, Timestamp: timestamp})
+
+	case *IngestRequest:
+		events = append(events, mutationEvents(&PutVertexRequest{Vertices: r.Vertices}, now)...)
+		events = append(events, mutationEvents(&AddEdgeRequest{Edges: r.Edges}, now)...)
+	}
+	return events
+}
+
+// expirationEvents returns events of vertices and edges removed by the sweeper of GraphCache.
+func expirationEvents(vertices []graph.Vertex[string, *Vertex], edges []graph.Edge[string], now time.Time) []*Event {
+	return removalEvents(EventType_EVENT_TYPE_EXPIRE_VERTEX, EventType_EVENT_TYPE_EXPIRE_EDGE, vertices, edges, now)
+}
+
+// evictionEvents returns events of vertices and edges evicted to keep GraphCache within its limits.
+func evictionEvents(vertices []graph.Vertex[string, *Vertex], edges []graph.Edge[string], now time.Time) []*Event {
+	return removalEvents(EventType_EVENT_TYPE_EVICT_VERTEX, EventType_EVENT_TYPE_EVICT_EDGE, vertices, edges, now)
+}
+
+// deletionEvents returns an event of each edge deleted together with its tail or head, since
+// the event of DeleteVertex names only the vertex.
+func deletionEvents(edges []graph.Edge[string], now time.Time) []*Event {
+	timestamp := timestamppb.New(now)
+	seen := make(map[[2]string]struct{}, len(edges))
+	events := make([]*Event, 0, len(edges))
+	for _, e := range edges {
+		if _, ok := seen[[2]string{e.Tail, e.Head}]; ok {
+			continue
+		}
+		seen[[2]string{e.Tail, e.Head}] = struct{}{}
+		events = append(events, &Event{Type: EventType_EVENT_TYPE_DELETE_EDGE, Edge: &Edge{Tail: e.Tail, Head: e.Head}, Timestamp: timestamp})
+	}
+	return events
+}
+
+func removalEvents(vertexType EventType, edgeType EventType, vertices []graph.Vertex[string, *Vertex], edges []graph.Edge[string], now time.Time) []*Event {
+	timestamp := timestamppb.New(now)
+	events := make([]*Event, 0, len(vertices)+len(edges))
+	for _, v := range vertices {
+		vertex := &Vertex{
+			Key:        v.Key,
+			Expiration: timestamppb.New(v.Expiration),
+		}
+		if v.Value != nil {
+			vertex.Value = v.Value.Value
+		} else {
+			vertex.Value = &Vertex_Nil{Nil: true}
+		}
+		events = append(events, &Event{Type: vertexType, Vertex: vertex, Timestamp: timestamp})
+	}
+	for _, e := range edges {
+		events = append(events, &Event{
+			Type: edgeType,
+			Edge: &Edge{
+				Tail:       e.Tail,
+				Head:       e.Head,
+				Weight:     e.Weight,
+				Expiration: timestamppb.New(e.Expiration),
+			},
+			Timestamp: timestamp,
+		})
+	}
+	return events
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	. "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestWatchers_Publish(t *testing.T) {
+	vertex := func(key string) *Event {
+		return &Event{Type: EventType_EVENT_TYPE_PUT_VERTEX, Vertex: &Vertex{Key: key}}
+	}
+	edge := func(tail, head string) *Event {
+		return &Event{Type: EventType_EVENT_TYPE_ADD_EDGE, Edge: &Edge{Tail: tail, Head: head}}
+	}
+
+	tests := []struct {
+		name   string
+		prefix string
+		events []*Event
+		want   int
+	}{
+		{name: "no prefix", prefix: "", events: []*Event{vertex("a"), edge("a", "b")}, want: 2},
+		{name: "vertex key", prefix: "user:", events: []*Event{vertex("user:1"), vertex("item:1")}, want: 1},
+		{name: "edge tail or head", prefix: "user:", events: []*Event{edge("user:1", "item:1"), edge("item:1", "user:1"), edge("item:1", "item:2")}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws := newWatchers()
+			w := ws.subscribe(tt.prefix)
+			ws.publish(tt.events)
+			ws.unsubscribe(w)
+
+			got := 0
+			for range w.c {
+				got++
+			}
+			if got != tt.want {
+				t.Errorf("publish() sends %d events, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatchers_Publish_SlowWatcher(t *testing.T) {
+	ws := newWatchers()
+	w := ws.subscribe("")
+	for i := 0; i <= watchBufferSize; i++ {
+		ws.publish([]*Event{{Type: EventType_EVENT_TYPE_DELETE_VERTEX, Vertex: &Vertex{Key: "a"}}})
+	}
+	for range w.c {
+	}
+	if w.err != errSlowWatcher {
+		t.Errorf("err = %v, want %v", w.err, errSlowWatcher)
+	}
+}
+
+func TestLanternService_Watch(t *testing.T) {
+	s := newTestService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.namespaces.feed(ctx, s.wal)
+	go s.cache.Watch(ctx, 10*time.Millisecond)
+
+	stream, err := startTestServer(t, s).Watch(ctx, &WatchRequest{Prefix: "a"})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	// Wait until the watcher is subscribed.
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		s.watchers.mu.Lock()
+		n := len(s.watchers.members)
+		s.watchers.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Watch() does not subscribe")
+		}
+	}
+
+	expiration := timestamppb.New(time.Now().Add(100 * time.Millisecond))
+	if _, err := s.PutVertex(ctx, &PutVertexRequest{Vertices: []*Vertex{
+		{Key: "a", Value: &Vertex_String_{String_: "a"}, Expiration: expiration},
+		{Key: "b", Value: &Vertex_String_{String_: "b"}, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("PutVertex() error = %v", err)
+	}
+	if _, err := s.DeleteEdge(ctx, &DeleteEdgeRequest{Tail: "b", Head: "c"}); err != nil {
+		t.Fatalf("DeleteEdge() error = %v", err)
+	}
+
+	want := []struct {
+		eventType EventType
+		key       string
+	}{
+		{eventType: EventType_EVENT_TYPE_PUT_VERTEX, key: "a"},
+		{eventType: EventType_EVENT_TYPE_EXPIRE_VERTEX, key: "a"},
+	}
+	for _, w := range want {
+		response, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if e := response.Event; e.Type != w.eventType || e.Vertex.GetKey() != w.key {
+			t.Errorf("Recv() = %v %s, want %v %s", e.Type, e.Vertex.GetKey(), w.eventType, w.key)
+		}
+	}
+}
+
+func TestLanternService_Watch_DeleteVertex(t *testing.T) {
+	s := newTestService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.namespaces.feed(ctx, s.wal)
+
+	stream, err := startTestServer(t, s).Watch(ctx, &WatchRequest{})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	// Wait until the watcher is subscribed.
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		s.watchers.mu.Lock()
+		n := len(s.watchers.members)
+		s.watchers.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Watch() does not subscribe")
+		}
+	}
+
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := s.AddEdge(ctx, &AddEdgeRequest{Edges: []*Edge{
+		{Tail: "a", Head: "b", Weight: 1, Expiration: expiration},
+		{Tail: "a", Head: "b", Weight: 2, Expiration: expiration},
+	}}); err != nil {
+		t.Fatalf("AddEdge() error = %v", err)
+	}
+	if _, err := s.DeleteVertex(ctx, &DeleteVertexRequest{Key: "b"}); err != nil {
+		t.Fatalf("DeleteVertex() error = %v", err)
+	}
+
+	want := []EventType{
+		EventType_EVENT_TYPE_ADD_EDGE,
+		EventType_EVENT_TYPE_ADD_EDGE,
+		EventType_EVENT_TYPE_DELETE_VERTEX,
+		EventType_EVENT_TYPE_DELETE_EDGE,
+	}
+	for _, w := range want {
+		response, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if response.Event.Type != w {
+			t.Errorf("Recv() = %v, want %v", response.Event.Type, w)
+		}
+	}
+	if _, edges := s.cache.Size(); edges != 0 {
+		t.Errorf("Size() edges = %d, want 0", edges)
+	}
+}
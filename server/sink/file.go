@@ -0,0 +1,57 @@
+package sink
+
+import (
+	"bufio"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"os"
+	"sync"
+)
+
+// FileSink appends events to a file as JSON lines, so that they can be archived.
+type FileSink struct {
+	mu     sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+}
+
+func OpenFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+func (s *FileSink) Send(events []*v1.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range events {
+		b, err := protojson.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if _, err := s.writer.Write(b); err != nil {
+			return err
+		}
+		if err := s.writer.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return s.writer.Flush()
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.writer.Flush(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
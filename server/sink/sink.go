@@ -0,0 +1,50 @@
+package sink
+
+import (
+	"errors"
+	"fmt"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"golang.org/x/exp/slog"
+	"strings"
+)
+
+var ErrUnknownSink = errors.New("unknown sink")
+
+// Sink receives events of vertices and edges before they are discarded, e.g. on expiration.
+type Sink interface {
+	Send(events []*v1.Event) error
+	Close() error
+}
+
+// Parse returns a Sink described by spec, which is "log" or "file:<path>".
+// An empty spec returns nil.
+func Parse(spec string) (Sink, error) {
+	switch {
+	case spec == "":
+		return nil, nil
+	case spec == "log":
+		return LogSink{}, nil
+	case strings.HasPrefix(spec, "file:"):
+		return OpenFileSink(strings.TrimPrefix(spec, "file:"))
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSink, spec)
+	}
+}
+
+// LogSink writes each event to the standard logger.
+type LogSink struct{}
+
+func (LogSink) Send(events []*v1.Event) error {
+	for _, e := range events {
+		if e.Vertex != nil {
+			slog.Info(e.Type.String(), "key", e.Vertex.Key)
+		} else {
+			slog.Info(e.Type.String(), "tail", e.Edge.GetTail(), "head", e.Edge.GetHead(), "weight", e.Edge.GetWeight())
+		}
+	}
+	return nil
+}
+
+func (LogSink) Close() error {
+	return nil
+}
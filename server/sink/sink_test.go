@@ -0,0 +1,104 @@
+package sink
+
+import (
+	"bufio"
+	"errors"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "expired.jsonl")
+	tests := []struct {
+		name    string
+		spec    string
+		want    string
+		wantErr error
+	}{
+		{name: "none", spec: "", want: "<nil>"},
+		{name: "log", spec: "log", want: "sink.LogSink"},
+		{name: "file", spec: "file:" + path, want: "*sink.FileSink"},
+		{name: "unknown", spec: "kafka://localhost", wantErr: ErrUnknownSink},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.spec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer func() {
+				if got != nil {
+					got.Close()
+				}
+			}()
+			if typ := typeName(got); typ != tt.want {
+				t.Errorf("Parse() = %s, want %s", typ, tt.want)
+			}
+		})
+	}
+}
+
+func typeName(s Sink) string {
+	switch s.(type) {
+	case nil:
+		return "<nil>"
+	case LogSink:
+		return "sink.LogSink"
+	case *FileSink:
+		return "*sink.FileSink"
+	default:
+		return "unknown"
+	}
+}
+
+func TestFileSink_Send(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "expired.jsonl")
+	events := []*v1.Event{
+		{Type: v1.EventType_EVENT_TYPE_EXPIRE_VERTEX, Vertex: &v1.Vertex{Key: "a"}},
+		{Type: v1.EventType_EVENT_TYPE_EXPIRE_EDGE, Edge: &v1.Edge{Tail: "a", Head: "b", Weight: 1}},
+	}
+
+	// Events are appended to the file across reopening.
+	for _, e := range events {
+		s, err := OpenFileSink(path)
+		if err != nil {
+			t.Fatalf("OpenFileSink() error = %v", err)
+		}
+		if err := s.Send([]*v1.Event{e}); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		if err := s.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("os.Open() error = %v", err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var got []*v1.Event
+	for scanner.Scan() {
+		e := &v1.Event{}
+		if err := protojson.Unmarshal(scanner.Bytes(), e); err != nil {
+			t.Fatalf("protojson.Unmarshal() error = %v", err)
+		}
+		got = append(got, e)
+	}
+	if len(got) != len(events) {
+		t.Fatalf("file has %d events, want %d", len(got), len(events))
+	}
+	for i := range events {
+		if !proto.Equal(got[i], events[i]) {
+			t.Errorf("event %d = %v, want %v", i, got[i], events[i])
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package storage
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// chunkSize is the maximum number of vertices or edges in one record of a snapshot file.
+const chunkSize = 1024
+
+// snapshotMagic is written at the beginning of a snapshot file. Files of snapshotMagicV1 have
+// the graph of the default namespace only, and are still loaded.
+const (
+	snapshotMagic   = "LNTNSNP2"
+	snapshotMagicV1 = "LNTNSNP1"
+)
+
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+// Snapshotter periodically writes point-in-time snapshots of the caches of all namespaces to a file.
+//
+// A snapshot file begins with snapshotMagic and the varint sequence number of the last
+// write-ahead log entry it contains, followed by a sequence of records, each of which is
+// a varint length prefix and a serialized v1.SnapshotChunk of a namespace. A vertex without
+// value (implicitly created by an edge) is stored as a v1.Vertex whose value is not set.
+type Snapshotter struct {
+	path     string
+	interval time.Duration
+	wal      *WAL
+}
+
+func NewSnapshotter(path string, interval time.Duration, wal *WAL) *Snapshotter {
+	return &Snapshotter{
+		path:     path,
+		interval: interval,
+		wal:      wal,
+	}
+}
+
+func (s *Snapshotter) Enabled() bool {
+	return s.path != ""
+}
+
+// Save writes a snapshot of the cache and compacts the write-ahead log which it supersedes.
+// The file is replaced atomically, so a crash while saving never leaves a partially written
+// snapshot behind, nor a compacted log without the snapshot.
+func (s *Snapshotter) Save() error {
+	if !s.Enabled() {
+		return nil
+	}
+	c, err := s.wal.checkpoint()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	w := bufio.NewWriter(tmp)
+	if _, err := w.WriteString(snapshotMagic); err != nil {
+		return err
+	}
+	if _, err := w.Write(binary.AppendUvarint(nil, c.Sequence)); err != nil {
+		return err
+	}
+	if err := c.Chunks(func(chunk *v1.SnapshotChunk) error {
+		return writeMessage(w, chunk)
+	}); err != nil {
+		return err
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+	// The rename must be durable before the log it supersedes is removed.
+	if err := syncDir(filepath.Dir(s.path)); err != nil {
+		return err
+	}
+	return s.wal.compact(c.Sequence)
+}
+
+// syncDir flushes entries of a directory, such as a file renamed into it, to the disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// Watch saves a snapshot every interval until ctx is done.
+func (s *Snapshotter) Watch(ctx context.Context) {
+	if !s.Enabled() {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Save(); err != nil {
+				slog.Error("Failed to save snapshot", "error", err)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// LoadSnapshot restores a snapshot into graphs, and returns the sequence number of the last
+// write-ahead log entry it contains. Entries which have already expired are discarded.
+// It is not an error that the snapshot file does not exist yet.
+func LoadSnapshot(path string, graphs Graphs) (uint64, error) {
+	if path == "" {
+		return 0, nil
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || (string(magic) != snapshotMagic && string(magic) != snapshotMagicV1) {
+		return 0, ErrInvalidSnapshot
+	}
+	seq, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, ErrInvalidSnapshot
+	}
+
+	for {
+		chunk := &v1.SnapshotChunk{}
+		if string(magic) == snapshotMagicV1 {
+			chunk.Graph = &v1.Graph{}
+			err = readMessage(r, chunk.Graph)
+		} else {
+			err = readMessage(r, chunk)
+		}
+		if err == io.EOF {
+			return seq, nil
+		} else if err != nil {
+			return 0, err
+		}
+		if err := Restore(graphs, chunk); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Checkpoint is a point-in-time copy of the caches of all namespaces with the sequence number
+// of the last mutation it contains.
+type Checkpoint struct {
+	Sequence   uint64
+	Namespaces []NamespaceCheckpoint
+}
+
+// NamespaceCheckpoint is a copy of the cache of a namespace described by Spec, which is nil
+// for the default namespace.
+type NamespaceCheckpoint struct {
+	Spec     *v1.Namespace
+	Vertices []graph.Vertex[string, *v1.Vertex]
+	Edges    []graph.Edge[string]
+}
+
+// Chunks calls fn with chunks of each namespace, whose graphs contain at most chunkSize vertices
+// or edges each. Vertices are passed before edges, and a namespace without them is passed in
+// a chunk of an empty graph. Restore restores them.
+func (c *Checkpoint) Chunks(fn func(chunk *v1.SnapshotChunk) error) error {
+	for _, n := range c.Namespaces {
+		if err := n.chunks(func(g *v1.Graph) error {
+			return fn(&v1.SnapshotChunk{Namespace: n.Spec, Graph: g})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *NamespaceCheckpoint) chunks(fn func(g *v1.Graph) error) error {
+	if c.Spec != nil && len(c.Vertices) == 0 && len(c.Edges) == 0 {
+		return fn(&v1.Graph{})
+	}
+	for i := 0; i < len(c.Vertices); i += chunkSize {
+		g := &v1.Graph{}
+		for _, v := range c.Vertices[i:chunkEnd(i, len(c.Vertices))] {
+			g.Vertices = append(g.Vertices, encodeVertex(v))
+		}
+		if err := fn(g); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < len(c.Edges); i += chunkSize {
+		g := &v1.Graph{}
+		for _, e := range c.Edges[i:chunkEnd(i, len(c.Edges))] {
+			g.Edges = append(g.Edges, &v1.Edge{
+				Tail:              e.Tail,
+				Head:              e.Head,
+				Weight:            e.Weight,
+				Expiration:        timestamppb.New(e.Expiration),
+				SlidingTtlSeconds: uint32(e.SlidingTTL / time.Second),
+				Added:             timestamppb.New(e.Added),
+			})
+		}
+		if err := fn(g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore adds vertices and edges of a chunk to the cache of its namespace, which is created
+// unless it exists.
+func Restore(graphs Graphs, chunk *v1.SnapshotChunk) error {
+	name := namespace.Default
+	if chunk.Namespace != nil {
+		name = chunk.Namespace.Name
+	}
+	cache, ok := graphs.Cache(name)
+	if !ok {
+		if err := graphs.Create(chunk.Namespace); err != nil {
+			return err
+		}
+		if cache, ok = graphs.Cache(name); !ok {
+			return fmt.Errorf("%w: %s", ErrNamespaceNotFound, name)
+		}
+	}
+	RestoreGraph(cache, chunk.Graph)
+	return nil
+}
+
+// RestoreGraph adds vertices and edges of a graph to the cache, and discards expired ones.
+func RestoreGraph(cache *graph.GraphCache[string, *v1.Vertex], g *v1.Graph) {
+	now := time.Now()
+	for _, v := range g.GetVertices() {
+		expiration := v.Expiration.AsTime()
+		if expiration.Before(now) {
+			continue
+		}
+		cache.AddVertexWithSlidingExpiration(v.Key, decodeVertex(v), expiration, seconds(v.SlidingTtlSeconds))
+	}
+	for _, e := range g.GetEdges() {
+		expiration := e.Expiration.AsTime()
+		if expiration.Before(now) {
+			continue
+		}
+		cache.AddEdgeAddedAt(e.Tail, e.Head, e.Weight, expiration, seconds(e.SlidingTtlSeconds), addedOf(e))
+	}
+}
+
+func chunkEnd(i, n int) int {
+	if i+chunkSize < n {
+		return i + chunkSize
+	}
+	return n
+}
+
+func encodeVertex(v graph.Vertex[string, *v1.Vertex]) *v1.Vertex {
+	if v.Value == nil {
+		return &v1.Vertex{
+			Key:               v.Key,
+			Expiration:        timestamppb.New(v.Expiration),
+			SlidingTtlSeconds: uint32(v.SlidingTTL / time.Second),
+		}
+	}
+	return &v1.Vertex{
+		Key:               v.Key,
+		Value:             v.Value.Value,
+		Expiration:        timestamppb.New(v.Expiration),
+		SlidingTtlSeconds: uint32(v.SlidingTTL / time.Second),
+	}
+}
+
+func decodeVertex(v *v1.Vertex) *v1.Vertex {
+	if v.Value == nil {
+		return nil
+	}
+	return v
+}
+
+func writeMessage(w io.Writer, m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return writeRecord(w, b)
+}
+
+func readMessage(r *bufio.Reader, m proto.Message) error {
+	b, err := readRecord(r)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, m)
+}
+
+// writeRecord writes b with a varint length prefix.
+func writeRecord(w io.Writer, b []byte) error {
+	if _, err := w.Write(binary.AppendUvarint(nil, uint64(len(b)))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// readRecord reads a record written by writeRecord. It returns io.EOF only if there is no
+// more record, and io.ErrUnexpectedEOF if the last record is truncated.
+func readRecord(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return b, nil
+}
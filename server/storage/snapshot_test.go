@@ -0,0 +1,80 @@
+package storage
+
+import (
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSnapshotter_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lantern.snapshot")
+
+	src := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	src.AddVertexWithTTL("a", &v1.Vertex{Key: "a", Value: &v1.Vertex_String_{String_: "A"}}, time.Minute)
+	src.AddVertexWithExpiration("expired", &v1.Vertex{Key: "expired"}, time.Now().Add(-time.Minute))
+	src.AddEdgeWithTTL("a", "b", 1, time.Minute)
+	src.AddEdgeWithTTL("a", "b", 2, time.Hour)
+	src.AddVertexWithSlidingExpiration("sliding", &v1.Vertex{Key: "sliding"}, time.Now().Add(time.Minute), time.Hour)
+
+	wal, err := OpenWAL("", SyncNever, newGraphs(src))
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	if err := NewSnapshotter(path, time.Minute, wal).Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	dst := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	if _, err := LoadSnapshot(path, newGraphs(dst)); err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantNil bool
+		wantOk  bool
+	}{
+		{name: "vertex with value", key: "a", want: "A", wantOk: true},
+		{name: "vertex created by edge", key: "b", wantNil: true, wantOk: true},
+		{name: "expired vertex", key: "expired", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := dst.GetVertex(tt.key)
+			if ok != tt.wantOk {
+				t.Fatalf("GetVertex() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("GetVertex() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && got.GetString_() != tt.want {
+				t.Errorf("GetVertex() = %v, want %v", got.GetString_(), tt.want)
+			}
+		})
+	}
+
+	if w, ok := dst.GetWeight("a", "b"); !ok || w != 3 {
+		t.Errorf("GetWeight() = %v, %v, want 3, true", w, ok)
+	}
+	dst.RangeVertices(func(v graph.Vertex[string, *v1.Vertex]) bool {
+		if v.Key == "sliding" && v.SlidingTTL != time.Hour {
+			t.Errorf("sliding TTL of %s = %v, want %v", v.Key, v.SlidingTTL, time.Hour)
+		}
+		return true
+	})
+}
+
+func TestLoadSnapshot_MissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.snapshot")
+	cache := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	if seq, err := LoadSnapshot(path, newGraphs(cache)); err != nil || seq != 0 {
+		t.Errorf("LoadSnapshot() = %v, %v, want 0, nil", seq, err)
+	}
+}
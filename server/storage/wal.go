@@ -0,0 +1,883 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type SyncPolicy int
+
+const (
+	// SyncAlways fsyncs the log before every mutation is acknowledged.
+	SyncAlways SyncPolicy = iota
+	// SyncEverySecond fsyncs the log once a second, so at most one second of mutations can be lost.
+	SyncEverySecond
+	// SyncNever leaves flushing to the operating system.
+	SyncNever
+)
+
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch s {
+	case "always":
+		return SyncAlways, nil
+	case "everysec", "":
+		return SyncEverySecond, nil
+	case "never":
+		return SyncNever, nil
+	default:
+		return 0, fmt.Errorf("unknown sync policy: %q", s)
+	}
+}
+
+type op byte
+
+const (
+	opPutVertex op = iota + 1
+	opDeleteVertex
+	opAddEdge
+	opPutEdge
+	opDeleteEdge
+	opTouch
+	opIngest
+	opCreateNamespace
+	opFlushNamespace
+	opDropNamespace
+)
+
+// opNamespaced is set in the operation byte of an entry of a namespace other than the default
+// one, and the name of the namespace follows the operation byte with a varint length prefix.
+const opNamespaced op = 0x80
+
+const segmentSuffix = ".wal"
+
+var (
+	ErrUnknownMutation    = errors.New("unknown mutation")
+	ErrCompacted          = errors.New("mutations are compacted")
+	ErrSlowSubscriber     = errors.New("subscriber is too slow")
+	ErrNamespaceNotFound  = errors.New("namespace not found")
+	ErrNamespaceExists    = errors.New("namespace already exists")
+	ErrEvictionNotAllowed = errors.New("evictions by reads are neither logged nor replicated")
+)
+
+// errStop stops reading a segment.
+var errStop = errors.New("stop")
+
+// Entry is a mutation of a namespace with its sequence number in the log. Mutations to create,
+// flush and drop a namespace belong to the default namespace, and name the namespace themselves.
+type Entry struct {
+	Sequence  uint64
+	Namespace string
+	Mutation  proto.Message
+	// Deleted are the edges deleted together with the vertex of a DeleteVertexRequest, which
+	// are set by Apply and never logged.
+	Deleted []graph.Edge[string]
+}
+
+// Graphs are the caches of namespaces which mutations are applied to. The default namespace
+// always exists, and the others are created and dropped by mutations.
+type Graphs interface {
+	// Cache returns the cache of a namespace.
+	Cache(name string) (*graph.GraphCache[string, *v1.Vertex], bool)
+	// Namespaces returns the namespaces other than the default one.
+	Namespaces() []*v1.Namespace
+	// Create starts an empty namespace described by spec, which does not exist.
+	Create(spec *v1.Namespace) error
+	// Drop stops a namespace, and discards its graph.
+	Drop(name string)
+}
+
+// Clear drops all namespaces other than the default one, and clears the default one.
+func Clear(graphs Graphs) {
+	for _, spec := range graphs.Namespaces() {
+		graphs.Drop(spec.Name)
+	}
+	if cache, ok := graphs.Cache(namespace.Default); ok {
+		cache.Clear()
+	}
+}
+
+// WAL is the write path of the caches of all namespaces. Every mutation is appended to a log on
+// disk before it is applied to the cache of its namespace, and the log is replayed on startup.
+//
+// The log is split into segment files named after the sequence number of their first entry.
+// Each entry is a varint length prefix followed by a varint sequence number, an operation
+// byte, the name of the namespace if it is not the default one, and the serialized request
+// of the mutation. Segments are deleted once a snapshot covers all of their entries.
+//
+// If dir is empty, mutations are applied to the caches without being logged, but they are
+// still numbered and published to subscribers.
+type WAL struct {
+	mu            sync.Mutex
+	dir           string
+	policy        SyncPolicy
+	graphs        Graphs
+	file          *os.File
+	writer        *bufio.Writer
+	oldest        uint64
+	first         uint64
+	seq           uint64
+	dirty         bool
+	entries       int
+	subscriptions map[*Subscription]struct{}
+}
+
+func OpenWAL(dir string, policy SyncPolicy, graphs Graphs) (*WAL, error) {
+	l := &WAL{
+		dir:           dir,
+		policy:        policy,
+		graphs:        graphs,
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+	if !l.Enabled() {
+		return l, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	segments, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range segments {
+		if err := readSegment(filepath.Join(dir, segmentName(s)), func(e Entry) error {
+			l.seq = e.Sequence
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if l.seq < s {
+			l.seq = s - 1
+		}
+	}
+
+	if err := l.rotate(); err != nil {
+		return nil, err
+	}
+	l.oldest = l.first
+	if len(segments) > 0 {
+		l.oldest = segments[0]
+	}
+	return l, nil
+}
+
+func (l *WAL) Enabled() bool {
+	return l.dir != ""
+}
+
+// Reproducible reports whether applying the mutations written to the log reproduces the caches,
+// which it does not if any of them evicts by reads, since neither reads nor evictions are logged.
+func (l *WAL) Reproducible() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	names := []string{namespace.Default}
+	for _, spec := range l.graphs.Namespaces() {
+		names = append(names, spec.Name)
+	}
+	for _, name := range names {
+		if cache, ok := l.graphs.Cache(name); ok && cache.EvictsByReads() {
+			return false
+		}
+	}
+	return true
+}
+
+// EvictsByReads reports whether a namespace described by spec evicts by reads, which makes
+// the log unable to reproduce it.
+func EvictsByReads(spec *v1.Namespace) bool {
+	return spec.EvictionPolicy == v1.EvictionPolicy_EVICTION_POLICY_LRU || spec.EvictionPolicy == v1.EvictionPolicy_EVICTION_POLICY_LFU
+}
+
+// Write appends m to the log and applies it to the cache of namespace ns.
+// Both are done while holding the log lock, so a checkpoint never observes a mutation
+// which is applied but not logged, or vice versa.
+func (l *WAL) Write(ns string, m proto.Message) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	o, err := opOf(m)
+	if err != nil {
+		return err
+	}
+	if err := l.check(ns, m); err != nil {
+		return err
+	}
+	m = withAdded(m, time.Now())
+
+	e := Entry{Sequence: l.seq + 1, Namespace: ns, Mutation: m}
+	if l.Enabled() {
+		if err := l.append(e, o); err != nil {
+			return err
+		}
+	}
+	if err := e.Apply(l.graphs); err != nil {
+		return err
+	}
+	l.seq = e.Sequence
+	l.publish(e)
+	return nil
+}
+
+// check returns an error if m cannot be applied to namespace ns, before it is logged.
+func (l *WAL) check(ns string, m proto.Message) error {
+	switch r := m.(type) {
+	case *v1.CreateNamespaceRequest:
+		if _, ok := l.graphs.Cache(r.GetNamespace().GetName()); ok {
+			return fmt.Errorf("%w: %s", ErrNamespaceExists, r.GetNamespace().GetName())
+		}
+		if l.Enabled() && EvictsByReads(r.Namespace) {
+			return ErrEvictionNotAllowed
+		}
+		return nil
+	case *v1.FlushNamespaceRequest:
+		return l.exists(r.Name)
+	case *v1.DropNamespaceRequest:
+		return l.exists(r.Name)
+	}
+
+	cache, ok := l.graphs.Cache(ns)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNamespaceNotFound, ns)
+	}
+	return Fits(cache, m)
+}
+
+func (l *WAL) exists(ns string) error {
+	if _, ok := l.graphs.Cache(ns); !ok {
+		return fmt.Errorf("%w: %s", ErrNamespaceNotFound, ns)
+	}
+	return nil
+}
+
+func (l *WAL) append(e Entry, o op) error {
+	b, err := proto.Marshal(e.Mutation)
+	if err != nil {
+		return err
+	}
+
+	payload := binary.AppendUvarint(nil, e.Sequence)
+	if e.Namespace != namespace.Default {
+		payload = append(payload, byte(o|opNamespaced))
+		payload = binary.AppendUvarint(payload, uint64(len(e.Namespace)))
+		payload = append(payload, e.Namespace...)
+	} else {
+		payload = append(payload, byte(o))
+	}
+	payload = append(payload, b...)
+	if err := writeRecord(l.writer, payload); err != nil {
+		return err
+	}
+	if err := l.writer.Flush(); err != nil {
+		return err
+	}
+	if l.policy == SyncAlways {
+		if err := l.file.Sync(); err != nil {
+			return err
+		}
+	} else {
+		l.dirty = true
+	}
+
+	l.entries++
+	return nil
+}
+
+// checkpoint exports the cache, and starts a new segment so that older segments can be compacted.
+func (l *WAL) checkpoint() (*Checkpoint, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c := l.export()
+	if !l.Enabled() {
+		return c, nil
+	}
+	if err := l.rotate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (l *WAL) export() *Checkpoint {
+	c := &Checkpoint{Sequence: l.seq}
+	if cache, ok := l.graphs.Cache(namespace.Default); ok {
+		vertices, edges := cache.Export()
+		c.Namespaces = append(c.Namespaces, NamespaceCheckpoint{Vertices: vertices, Edges: edges})
+	}
+	for _, spec := range l.graphs.Namespaces() {
+		if cache, ok := l.graphs.Cache(spec.Name); ok {
+			vertices, edges := cache.Export()
+			c.Namespaces = append(c.Namespaces, NamespaceCheckpoint{Spec: spec, Vertices: vertices, Edges: edges})
+		}
+	}
+	return c
+}
+
+// rotate closes the current segment and starts a new one, unless the current segment is empty.
+func (l *WAL) rotate() error {
+	if l.file != nil && l.entries == 0 {
+		return nil
+	}
+	if err := l.closeSegment(); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(filepath.Join(l.dir, segmentName(l.seq+1)), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	l.file = f
+	l.writer = bufio.NewWriter(f)
+	l.first = l.seq + 1
+	l.entries = 0
+	return nil
+}
+
+func (l *WAL) closeSegment() error {
+	if l.file == nil {
+		return nil
+	}
+	if err := l.writer.Flush(); err != nil {
+		return err
+	}
+	if l.policy != SyncNever {
+		if err := l.file.Sync(); err != nil {
+			return err
+		}
+	}
+	l.dirty = false
+	err := l.file.Close()
+	l.file = nil
+	l.writer = nil
+	return err
+}
+
+// compact deletes all segments whose entries are covered by a snapshot up to seq.
+func (l *WAL) compact(seq uint64) error {
+	if !l.Enabled() {
+		return nil
+	}
+
+	l.mu.Lock()
+	current := l.first
+	l.mu.Unlock()
+
+	segments, err := listSegments(l.dir)
+	if err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(segments); i++ {
+		s := segments[i]
+		// A segment ends right before the next one begins.
+		if s >= current || segments[i+1]-1 > seq {
+			break
+		}
+
+		// Readers must not start reading a segment being deleted.
+		l.mu.Lock()
+		l.oldest = segments[i+1]
+		l.mu.Unlock()
+		if err := os.Remove(filepath.Join(l.dir, segmentName(s))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Readable reports whether all mutations after seq are still in the log.
+func (l *WAL) Readable(seq uint64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.Enabled() && seq <= l.seq && l.oldest <= seq+1
+}
+
+// Read calls fn for each mutation whose sequence number is in (from, to].
+// It returns ErrCompacted if some of them are no longer in the log.
+func (l *WAL) Read(from, to uint64, fn func(e Entry) error) error {
+	if !l.Readable(from) {
+		return ErrCompacted
+	}
+
+	segments, err := listSegments(l.dir)
+	if err != nil {
+		return err
+	}
+	next := from + 1
+	for i, s := range segments {
+		if next > to {
+			return nil
+		}
+		if i+1 < len(segments) && segments[i+1] <= next {
+			continue
+		}
+		if err := readSegment(filepath.Join(l.dir, segmentName(s)), func(e Entry) error {
+			if e.Sequence < next {
+				return nil
+			}
+			if e.Sequence > to {
+				return errStop
+			}
+			if err := fn(e); err != nil {
+				return err
+			}
+			next = e.Sequence + 1
+			return nil
+		}); errors.Is(err, os.ErrNotExist) {
+			return ErrCompacted
+		} else if err != nil {
+			return err
+		}
+	}
+	if next <= to {
+		return ErrCompacted
+	}
+	return nil
+}
+
+// Subscription receives mutations written after it was created.
+type Subscription struct {
+	wal *WAL
+	c   chan Entry
+	err error
+}
+
+// C returns a channel of mutations. It is closed when the subscription is closed, or when
+// the subscriber falls behind by more than its buffer; Err reports the latter.
+func (s *Subscription) C() <-chan Entry {
+	return s.c
+}
+
+func (s *Subscription) Err() error {
+	s.wal.mu.Lock()
+	defer s.wal.mu.Unlock()
+
+	return s.err
+}
+
+func (s *Subscription) Close() {
+	s.wal.mu.Lock()
+	defer s.wal.mu.Unlock()
+
+	s.wal.unsubscribe(s)
+}
+
+// Subscribe returns a subscription of mutations after the returned sequence number.
+func (l *WAL) Subscribe(buffer int) (*Subscription, uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.subscribe(buffer), l.seq
+}
+
+// SubscribeWithCheckpoint returns a subscription of mutations after a checkpoint exported
+// at the same time.
+func (l *WAL) SubscribeWithCheckpoint(buffer int) (*Subscription, *Checkpoint) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.subscribe(buffer), l.export()
+}
+
+func (l *WAL) subscribe(buffer int) *Subscription {
+	s := &Subscription{
+		wal: l,
+		c:   make(chan Entry, buffer),
+	}
+	l.subscriptions[s] = struct{}{}
+	return s
+}
+
+func (l *WAL) unsubscribe(s *Subscription) {
+	if _, ok := l.subscriptions[s]; !ok {
+		return
+	}
+	delete(l.subscriptions, s)
+	close(s.c)
+}
+
+func (l *WAL) publish(e Entry) {
+	for s := range l.subscriptions {
+		select {
+		case s.c <- e:
+		default:
+			s.err = ErrSlowSubscriber
+			l.unsubscribe(s)
+		}
+	}
+}
+
+func (l *WAL) sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil || !l.dirty {
+		return nil
+	}
+	l.dirty = false
+	return l.file.Sync()
+}
+
+// Watch fsyncs the log every second until ctx is done, if the policy is SyncEverySecond.
+func (l *WAL) Watch(ctx context.Context) {
+	if !l.Enabled() || l.policy != SyncEverySecond {
+		return
+	}
+
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := l.sync(); err != nil {
+				slog.Error("Failed to sync write-ahead log", "error", err)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (l *WAL) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.closeSegment()
+}
+
+// ReplayWAL applies all logged mutations newer than seq to graphs.
+func ReplayWAL(dir string, seq uint64, graphs Graphs) error {
+	if dir == "" {
+		return nil
+	}
+
+	segments, err := listSegments(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, s := range segments {
+		if err := readSegment(filepath.Join(dir, segmentName(s)), func(e Entry) error {
+			if e.Sequence <= seq {
+				return nil
+			}
+			return e.Apply(graphs)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply applies the mutation of e to the cache of its namespace, or creates, flushes or drops
+// a namespace.
+func (e *Entry) Apply(graphs Graphs) error {
+	switch r := e.Mutation.(type) {
+	case *v1.CreateNamespaceRequest:
+		return graphs.Create(r.Namespace)
+
+	case *v1.FlushNamespaceRequest:
+		cache, ok := graphs.Cache(r.Name)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrNamespaceNotFound, r.Name)
+		}
+		cache.Clear()
+		return nil
+
+	case *v1.DropNamespaceRequest:
+		graphs.Drop(r.Name)
+		return nil
+	}
+
+	cache, ok := graphs.Cache(e.Namespace)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNamespaceNotFound, e.Namespace)
+	}
+	if r, ok := e.Mutation.(*v1.DeleteVertexRequest); ok {
+		e.Deleted = cache.DeleteVertex(r.Key)
+		return nil
+	}
+	return Apply(cache, e.Mutation)
+}
+
+// Apply applies a mutation request to the cache.
+func Apply(cache *graph.GraphCache[string, *v1.Vertex], m proto.Message) error {
+	switch r := m.(type) {
+	case *v1.PutVertexRequest:
+		for _, v := range r.Vertices {
+			cache.AddVertexWithSlidingExpiration(v.Key, v, v.Expiration.AsTime(), seconds(v.SlidingTtlSeconds))
+		}
+
+	case *v1.DeleteVertexRequest:
+		cache.DeleteVertex(r.Key)
+
+	case *v1.AddEdgeRequest:
+		for _, e := range r.Edges {
+			cache.AddEdgeAddedAt(e.Tail, e.Head, e.Weight, e.Expiration.AsTime(), seconds(e.SlidingTtlSeconds), addedOf(e))
+		}
+
+	case *v1.PutEdgeRequest:
+		for _, e := range r.Edges {
+			cache.DeleteEdge(e.Tail, e.Head)
+			cache.AddEdgeAddedAt(e.Tail, e.Head, e.Weight, e.Expiration.AsTime(), seconds(e.SlidingTtlSeconds), addedOf(e))
+		}
+
+	case *v1.DeleteEdgeRequest:
+		cache.DeleteEdge(r.Tail, r.Head)
+
+	case *v1.IngestRequest:
+		if err := Apply(cache, &v1.PutVertexRequest{Vertices: r.Vertices}); err != nil {
+			return err
+		}
+		return Apply(cache, &v1.AddEdgeRequest{Edges: r.Edges})
+
+	case *v1.TouchRequest:
+		expiration := r.Expiration.AsTime()
+		for _, key := range r.Keys {
+			cache.TouchVertex(key, expiration)
+		}
+		for _, e := range r.Edges {
+			cache.TouchEdge(e.Tail, e.Head, expiration)
+		}
+
+	default:
+		return ErrUnknownMutation
+	}
+	return nil
+}
+
+func seconds(s uint32) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// addedOf returns when the weight of e is added, or now if it is not set.
+func addedOf(e *v1.Edge) time.Time {
+	if e.Added == nil {
+		return time.Now()
+	}
+	return e.Added.AsTime()
+}
+
+// withAdded returns a copy of m whose edges without the time they are added are added at added,
+// so that they decay from the same time when they are replayed, restored or replicated.
+func withAdded(m proto.Message, added time.Time) proto.Message {
+	var edges []*v1.Edge
+	switch r := m.(type) {
+	case *v1.AddEdgeRequest:
+		r = proto.Clone(r).(*v1.AddEdgeRequest)
+		m, edges = r, r.Edges
+	case *v1.PutEdgeRequest:
+		r = proto.Clone(r).(*v1.PutEdgeRequest)
+		m, edges = r, r.Edges
+	case *v1.IngestRequest:
+		r = proto.Clone(r).(*v1.IngestRequest)
+		m, edges = r, r.Edges
+	}
+	for _, e := range edges {
+		if e.Added == nil {
+			e.Added = timestamppb.New(added)
+		}
+	}
+	return m
+}
+
+// Fits returns graph.ErrLimitExceeded if the cache rejects m for exceeding its limits.
+// Followers and replays do not check it, since the leader has accepted m already.
+func Fits(cache *graph.GraphCache[string, *v1.Vertex], m proto.Message) error {
+	var vertices []graph.Vertex[string, *v1.Vertex]
+	var edges []graph.Edge[string]
+	switch r := m.(type) {
+	case *v1.PutVertexRequest:
+		for _, v := range r.Vertices {
+			vertices = append(vertices, graph.Vertex[string, *v1.Vertex]{Key: v.Key, Value: v})
+		}
+	case *v1.AddEdgeRequest:
+		for _, e := range r.Edges {
+			edges = append(edges, graph.Edge[string]{Tail: e.Tail, Head: e.Head, Weight: e.Weight})
+		}
+	case *v1.PutEdgeRequest:
+		for _, e := range r.Edges {
+			edges = append(edges, graph.Edge[string]{Tail: e.Tail, Head: e.Head, Weight: e.Weight})
+		}
+	case *v1.IngestRequest:
+		for _, v := range r.Vertices {
+			vertices = append(vertices, graph.Vertex[string, *v1.Vertex]{Key: v.Key, Value: v})
+		}
+		for _, e := range r.Edges {
+			edges = append(edges, graph.Edge[string]{Tail: e.Tail, Head: e.Head, Weight: e.Weight})
+		}
+	default:
+		return nil
+	}
+	return cache.Fits(vertices, edges)
+}
+
+func opOf(m proto.Message) (op, error) {
+	switch m.(type) {
+	case *v1.PutVertexRequest:
+		return opPutVertex, nil
+	case *v1.DeleteVertexRequest:
+		return opDeleteVertex, nil
+	case *v1.AddEdgeRequest:
+		return opAddEdge, nil
+	case *v1.PutEdgeRequest:
+		return opPutEdge, nil
+	case *v1.DeleteEdgeRequest:
+		return opDeleteEdge, nil
+	case *v1.TouchRequest:
+		return opTouch, nil
+	case *v1.IngestRequest:
+		return opIngest, nil
+	case *v1.CreateNamespaceRequest:
+		return opCreateNamespace, nil
+	case *v1.FlushNamespaceRequest:
+		return opFlushNamespace, nil
+	case *v1.DropNamespaceRequest:
+		return opDropNamespace, nil
+	default:
+		return 0, ErrUnknownMutation
+	}
+}
+
+func newMutation(o op) (proto.Message, error) {
+	switch o {
+	case opPutVertex:
+		return &v1.PutVertexRequest{}, nil
+	case opDeleteVertex:
+		return &v1.DeleteVertexRequest{}, nil
+	case opAddEdge:
+		return &v1.AddEdgeRequest{}, nil
+	case opPutEdge:
+		return &v1.PutEdgeRequest{}, nil
+	case opDeleteEdge:
+		return &v1.DeleteEdgeRequest{}, nil
+	case opTouch:
+		return &v1.TouchRequest{}, nil
+	case opIngest:
+		return &v1.IngestRequest{}, nil
+	case opCreateNamespace:
+		return &v1.CreateNamespaceRequest{}, nil
+	case opFlushNamespace:
+		return &v1.FlushNamespaceRequest{}, nil
+	case opDropNamespace:
+		return &v1.DropNamespaceRequest{}, nil
+	default:
+		return nil, ErrUnknownMutation
+	}
+}
+
+func decodeEntry(b []byte) (Entry, error) {
+	r := bytes.NewReader(b)
+	seq, err := binary.ReadUvarint(r)
+	if err != nil {
+		return Entry{}, err
+	}
+	o, err := r.ReadByte()
+	if err != nil {
+		return Entry{}, err
+	}
+	ns := namespace.Default
+	if op(o)&opNamespaced != 0 {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return Entry{}, err
+		}
+		name := make([]byte, size)
+		if _, err := io.ReadFull(r, name); err != nil {
+			return Entry{}, err
+		}
+		ns = string(name)
+	}
+	m, err := newMutation(op(o) &^ opNamespaced)
+	if err != nil {
+		return Entry{}, err
+	}
+	if err := proto.Unmarshal(b[len(b)-r.Len():], m); err != nil {
+		return Entry{}, err
+	}
+	return Entry{Sequence: seq, Namespace: ns, Mutation: m}, nil
+}
+
+// readSegment calls fn for each entry of a segment. A truncated entry at the end of
+// a segment, which is left by a crash while writing, is ignored.
+func readSegment(path string, fn func(e Entry) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		b, err := readRecord(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err == io.ErrUnexpectedEOF {
+			slog.Warn("Ignoring truncated entry at the end of write-ahead log", "path", path)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		e, err := decodeEntry(b)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if err := fn(e); err == errStop {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func segmentName(first uint64) string {
+	return fmt.Sprintf("%020d%s", first, segmentSuffix)
+}
+
+// listSegments returns the first sequence numbers of all segments in dir in ascending order.
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []uint64
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, first)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
@@ -0,0 +1,288 @@
+package storage
+
+import (
+	"errors"
+	v1 "github.com/anaregdesign/lantern-proto/go/graph/v1"
+	"github.com/anaregdesign/lantern/server/graph"
+	"github.com/anaregdesign/lantern/server/namespace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// testGraphs are caches of namespaces created with the default TTL of their specs.
+type testGraphs struct {
+	caches map[string]*graph.GraphCache[string, *v1.Vertex]
+	specs  map[string]*v1.Namespace
+}
+
+func newGraphs(cache *graph.GraphCache[string, *v1.Vertex]) *testGraphs {
+	return &testGraphs{
+		caches: map[string]*graph.GraphCache[string, *v1.Vertex]{namespace.Default: cache},
+		specs:  make(map[string]*v1.Namespace),
+	}
+}
+
+func (g *testGraphs) Cache(name string) (*graph.GraphCache[string, *v1.Vertex], bool) {
+	cache, ok := g.caches[name]
+	return cache, ok
+}
+
+func (g *testGraphs) Namespaces() []*v1.Namespace {
+	var specs []*v1.Namespace
+	for _, spec := range g.specs {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Name < specs[j].Name })
+	return specs
+}
+
+func (g *testGraphs) Create(spec *v1.Namespace) error {
+	if _, ok := g.caches[spec.Name]; ok {
+		return ErrNamespaceExists
+	}
+	g.caches[spec.Name] = graph.NewGraphCache[string, *v1.Vertex](seconds(spec.DefaultTtlSeconds))
+	g.specs[spec.Name] = spec
+	return nil
+}
+
+func (g *testGraphs) Drop(name string) {
+	delete(g.caches, name)
+	delete(g.specs, name)
+}
+
+func TestWAL_Replay(t *testing.T) {
+	dir := t.TempDir()
+	snapshot := filepath.Join(dir, "lantern.snapshot")
+	logDir := filepath.Join(dir, "wal")
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	touched := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+	src := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	wal, err := OpenWAL(logDir, SyncAlways, newGraphs(src))
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+
+	// Mutations before the snapshot are restored from the snapshot,
+	// and the others are replayed from the log.
+	mutations := []proto.Message{
+		&v1.AddEdgeRequest{Edges: []*v1.Edge{{Tail: "a", Head: "b", Weight: 1, Expiration: expiration}}},
+		&v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "a", Value: &v1.Vertex_Int64{Int64: 1}, Expiration: expiration}}},
+	}
+	for _, m := range mutations {
+		if err := wal.Write(namespace.Default, m); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := NewSnapshotter(snapshot, time.Minute, wal).Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	mutations = []proto.Message{
+		&v1.AddEdgeRequest{Edges: []*v1.Edge{{Tail: "a", Head: "b", Weight: 2, Expiration: expiration}}},
+		&v1.PutEdgeRequest{Edges: []*v1.Edge{{Tail: "b", Head: "c", Weight: 5, Expiration: expiration}}},
+		&v1.DeleteVertexRequest{Key: "c"},
+		&v1.DeleteEdgeRequest{Tail: "b", Head: "c"},
+		&v1.TouchRequest{Keys: []string{"a"}, Expiration: timestamppb.New(touched)},
+	}
+	for _, m := range mutations {
+		if err := wal.Write(namespace.Default, m); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := wal.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	segments, err := listSegments(logDir)
+	if err != nil {
+		t.Fatalf("listSegments() error = %v", err)
+	}
+	if len(segments) != 1 || segments[0] != 3 {
+		t.Errorf("listSegments() = %v, want [3]", segments)
+	}
+
+	dst := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	seq, err := LoadSnapshot(snapshot, newGraphs(dst))
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if seq != 2 {
+		t.Errorf("LoadSnapshot() = %v, want 2", seq)
+	}
+	if err := ReplayWAL(logDir, seq, newGraphs(dst)); err != nil {
+		t.Fatalf("ReplayWAL() error = %v", err)
+	}
+
+	if w, ok := dst.GetWeight("a", "b"); !ok || w != 3 {
+		t.Errorf("GetWeight(a, b) = %v, %v, want 3, true", w, ok)
+	}
+	if w, ok := dst.GetWeight("b", "c"); ok {
+		t.Errorf("GetWeight(b, c) = %v, %v, want 0, false", w, ok)
+	}
+	if v, ok := dst.GetVertex("a"); !ok || v.GetInt64() != 1 {
+		t.Errorf("GetVertex(a) = %v, %v, want 1, true", v, ok)
+	}
+	if _, ok := dst.GetVertex("c"); ok {
+		t.Errorf("GetVertex(c) is not deleted")
+	}
+	dst.RangeVertices(func(v graph.Vertex[string, *v1.Vertex]) bool {
+		if v.Key == "a" && !v.Expiration.Equal(touched) {
+			t.Errorf("expiration of a = %v, want %v", v.Expiration, touched)
+		}
+		return true
+	})
+
+	// Reopening the log continues the sequence.
+	reopened, err := OpenWAL(logDir, SyncNever, newGraphs(dst))
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	defer reopened.Close()
+	if reopened.seq != 7 {
+		t.Errorf("OpenWAL() seq = %v, want 7", reopened.seq)
+	}
+}
+
+func TestWAL_Replay_decay(t *testing.T) {
+	dir := t.TempDir()
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+
+	src := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	wal, err := OpenWAL(dir, SyncAlways, newGraphs(src))
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	mutations := []proto.Message{
+		&v1.AddEdgeRequest{Edges: []*v1.Edge{{Tail: "a", Head: "b", Weight: 4, Expiration: expiration, Added: timestamppb.New(time.Now().Add(-2 * time.Hour))}}},
+		&v1.PutEdgeRequest{Edges: []*v1.Edge{{Tail: "a", Head: "c", Weight: 4, Expiration: expiration}}},
+	}
+	for _, m := range mutations {
+		if err := wal.Write(namespace.Default, m); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := wal.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Edges are logged with the time they are added.
+	if err := readSegment(filepath.Join(dir, segmentName(1)), func(e Entry) error {
+		if r, ok := e.Mutation.(*v1.PutEdgeRequest); ok && r.Edges[0].Added == nil {
+			t.Errorf("logged %v without the time it is added", r)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("readSegment() error = %v", err)
+	}
+
+	// Replayed weights decay from when they were added, not from the replay.
+	dst := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	dst.SetHalfLife(time.Hour)
+	if err := ReplayWAL(dir, 0, newGraphs(dst)); err != nil {
+		t.Fatalf("ReplayWAL() error = %v", err)
+	}
+	if w, ok := dst.GetWeight("a", "b"); !ok || math.Abs(float64(w)-1) > 1e-3 {
+		t.Errorf("GetWeight(a, b) = %v, %v, want 1, true", w, ok)
+	}
+	if w, ok := dst.GetWeight("a", "c"); !ok || math.Abs(float64(w)-4) > 1e-3 {
+		t.Errorf("GetWeight(a, c) = %v, %v, want 4, true", w, ok)
+	}
+}
+
+func TestRestoreGraph_decay(t *testing.T) {
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	cache := graph.NewGraphCache[string, *v1.Vertex](time.Minute)
+	cache.SetHalfLife(time.Hour)
+	RestoreGraph(cache, &v1.Graph{Edges: []*v1.Edge{
+		{Tail: "a", Head: "b", Weight: 4, Expiration: expiration, Added: timestamppb.New(time.Now().Add(-time.Hour))},
+	}})
+	if w, ok := cache.GetWeight("a", "b"); !ok || math.Abs(float64(w)-2) > 1e-3 {
+		t.Errorf("GetWeight(a, b) = %v, %v, want 2, true", w, ok)
+	}
+}
+
+func TestWAL_Replay_namespaces(t *testing.T) {
+	dir := t.TempDir()
+	snapshot := filepath.Join(dir, "lantern.snapshot")
+	logDir := filepath.Join(dir, "wal")
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+
+	src := newGraphs(graph.NewGraphCache[string, *v1.Vertex](time.Minute))
+	wal, err := OpenWAL(logDir, SyncAlways, src)
+	if err != nil {
+		t.Fatalf("OpenWAL() error = %v", err)
+	}
+	write := func(ns string, m proto.Message) {
+		t.Helper()
+		if err := wal.Write(ns, m); err != nil {
+			t.Fatalf("Write(%s, %v) error = %v", ns, m, err)
+		}
+	}
+
+	// Namespaces created before the snapshot are restored from the snapshot, including
+	// an empty one, and the others are created by replaying the log.
+	write(namespace.Default, &v1.CreateNamespaceRequest{Namespace: &v1.Namespace{Name: "fraud", DefaultTtlSeconds: 600}})
+	write("fraud", &v1.AddEdgeRequest{Edges: []*v1.Edge{{Tail: "a", Head: "b", Weight: 1, Expiration: expiration}}})
+	write(namespace.Default, &v1.CreateNamespaceRequest{Namespace: &v1.Namespace{Name: "empty"}})
+	write(namespace.Default, &v1.CreateNamespaceRequest{Namespace: &v1.Namespace{Name: "dropped"}})
+	if err := NewSnapshotter(snapshot, time.Minute, wal).Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	write("fraud", &v1.AddEdgeRequest{Edges: []*v1.Edge{{Tail: "a", Head: "b", Weight: 2, Expiration: expiration}}})
+	write(namespace.Default, &v1.CreateNamespaceRequest{Namespace: &v1.Namespace{Name: "recommender"}})
+	write("recommender", &v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "x", Expiration: expiration}}})
+	write("recommender", &v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "y", Expiration: expiration}}})
+	write(namespace.Default, &v1.FlushNamespaceRequest{Name: "recommender"})
+	write("recommender", &v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "z", Expiration: expiration}}})
+	write(namespace.Default, &v1.DropNamespaceRequest{Name: "dropped"})
+
+	if err := wal.Write("missing", &v1.PutVertexRequest{Vertices: []*v1.Vertex{{Key: "x"}}}); !errors.Is(err, ErrNamespaceNotFound) {
+		t.Errorf("Write() to a missing namespace error = %v, want %v", err, ErrNamespaceNotFound)
+	}
+	if err := wal.Write(namespace.Default, &v1.CreateNamespaceRequest{Namespace: &v1.Namespace{Name: "fraud"}}); !errors.Is(err, ErrNamespaceExists) {
+		t.Errorf("Write() of an existing namespace error = %v, want %v", err, ErrNamespaceExists)
+	}
+	if err := wal.Write(namespace.Default, &v1.CreateNamespaceRequest{Namespace: &v1.Namespace{Name: "lru", EvictionPolicy: v1.EvictionPolicy_EVICTION_POLICY_LRU}}); !errors.Is(err, ErrEvictionNotAllowed) {
+		t.Errorf("Write() of a namespace evicting by reads error = %v, want %v", err, ErrEvictionNotAllowed)
+	}
+	if err := wal.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	dst := newGraphs(graph.NewGraphCache[string, *v1.Vertex](time.Minute))
+	seq, err := LoadSnapshot(snapshot, dst)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if err := ReplayWAL(logDir, seq, dst); err != nil {
+		t.Fatalf("ReplayWAL() error = %v", err)
+	}
+
+	var names []string
+	for _, spec := range dst.Namespaces() {
+		names = append(names, spec.Name)
+	}
+	if want := []string{"empty", "fraud", "recommender"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Namespaces() = %v, want %v", names, want)
+	}
+	if spec := dst.specs["fraud"]; spec == nil || spec.DefaultTtlSeconds != 600 {
+		t.Errorf("spec of fraud = %v, want default TTL of 600 seconds", spec)
+	}
+	if w, ok := dst.caches["fraud"].GetWeight("a", "b"); !ok || w != 3 {
+		t.Errorf("GetWeight(a, b) in fraud = %v, %v, want 3, true", w, ok)
+	}
+	if _, ok := dst.caches[namespace.Default].GetVertex("a"); ok {
+		t.Errorf("GetVertex(a) in the default namespace is found")
+	}
+	for key, want := range map[string]bool{"x": false, "y": false, "z": true} {
+		if _, ok := dst.caches["recommender"].GetVertex(key); ok != want {
+			t.Errorf("GetVertex(%s) in recommender ok = %v, want %v", key, ok, want)
+		}
+	}
+}
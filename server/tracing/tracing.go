@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"os"
+)
+
+var ErrUnknownExporter = errors.New("unknown trace exporter")
+
+const serviceName = "lantern-server"
+
+// NewTracerProvider returns a tracer provider exporting spans to exporter, which is one of
+//   - "": spans are not recorded
+//   - "stdout": spans are written to the standard output
+//   - "otlp": spans are sent to an OTLP collector over gRPC, configured by the standard
+//     environment variables like OTEL_EXPORTER_OTLP_ENDPOINT (localhost:4317 by default)
+//
+// The provider and the W3C trace context propagator are set as globals, so that spans of
+// clients and other nodes are joined.
+func NewTracerProvider(ctx context.Context, exporter string) (*sdktrace.TracerProvider, error) {
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	}
+	switch exporter {
+	case "":
+		options = append(options, sdktrace.WithSampler(sdktrace.NeverSample()))
+
+	case "stdout":
+		e, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithBatcher(e))
+
+	case "otlp":
+		e, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithBatcher(e))
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, exporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp, nil
+}
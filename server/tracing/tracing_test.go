@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewTracerProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		exporter string
+		wantErr  error
+	}{
+		{name: "disabled", exporter: ""},
+		{name: "stdout", exporter: "stdout"},
+		{name: "unknown", exporter: "zipkin", wantErr: ErrUnknownExporter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp, err := NewTracerProvider(context.Background(), tt.exporter)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewTracerProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if err := tp.Shutdown(context.Background()); err != nil {
+					t.Errorf("Shutdown() error = %v", err)
+				}
+			}
+		})
+	}
+}